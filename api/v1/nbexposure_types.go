@@ -0,0 +1,80 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NBExposureSpec defines the desired state of NBExposure.
+type NBExposureSpec struct {
+	// ServiceSelector selects Services exposed by this NBExposure, an empty selector matches all Services
+	ServiceSelector metav1.LabelSelector `json:"serviceSelector"`
+	// NamespaceSelector limits matched Services to Namespaces matching the selector, all Namespaces if unset
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+	// Priority of this NBExposure over other NBExposures matching the same Service, higher wins
+	// +optional
+	Priority int32 `json:"priority,omitempty"`
+	// Groups NetBird groups of the exposed resources, equivalent to netbird.io/groups
+	// +optional
+	// +kubebuilder:validation:items:MinLength=1
+	Groups []string `json:"groups,omitempty"`
+	// NetworkRef NBRoutingPeer routing traffic to the exposed Services, equivalent to netbird.io/network
+	// +optional
+	NetworkRef *NBResourceNetworkRef `json:"networkRef,omitempty"`
+	// Policies NBPolicies the exposed resources are added to, equivalent to netbird.io/policy
+	// +optional
+	// +kubebuilder:validation:items:MinLength=1
+	Policies []string `json:"policies,omitempty"`
+	// PolicyPorts restricts policies to these ports, equivalent to netbird.io/policy-ports
+	// +optional
+	// +kubebuilder:validation:items:Minimum=0
+	// +kubebuilder:validation:items:Maximum=65535
+	PolicyPorts []int32 `json:"policyPorts,omitempty"`
+	// PolicyProtocol restricts policies to this protocol, equivalent to netbird.io/policy-protocol
+	// +optional
+	// +kubebuilder:validation:Enum=tcp;udp
+	PolicyProtocol string `json:"policyProtocol,omitempty"`
+	// PolicySourceGroups source groups of automatically created policies, equivalent to netbird.io/policy-source-groups
+	// +optional
+	// +kubebuilder:validation:items:MinLength=1
+	PolicySourceGroups []string `json:"policySourceGroups,omitempty"`
+}
+
+// NBExposureStatus defines the observed state of NBExposure.
+type NBExposureStatus struct {
+	// MatchedServices Services matched by this NBExposure as namespace/name
+	// +optional
+	MatchedServices []string `json:"matchedServices,omitempty"`
+	// +optional
+	Conditions []NBCondition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="Priority",type=integer,JSONPath=`.spec.priority`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// NBExposure is the Schema for the nbexposures API.
+// Services matching the selectors are exposed as if annotated with netbird.io/expose,
+// annotations on the Service itself take precedence over NBExposure settings.
+type NBExposure struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NBExposureSpec   `json:"spec,omitempty"`
+	Status NBExposureStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NBExposureList contains a list of NBExposure.
+type NBExposureList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NBExposure `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&NBExposure{}, &NBExposureList{})
+}
@@ -8,13 +8,23 @@ import (
 )
 
 // NBResourceSpec defines the desired state of NBResource.
+// +kubebuilder:validation:XValidation:rule="has(self.networkID) != has(self.networkRef)",message="exactly one of networkID or networkRef must be set"
 type NBResourceSpec struct {
 	// +kubebuilder:validation:MinLength=1
 	Name string `json:"name"`
+	// NetworkID ID of the NetBird Network
+	// +optional
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
-	NetworkID string `json:"networkID"`
+	NetworkID string `json:"networkID,omitempty"`
+	// NetworkRef NBRoutingPeer whose NetBird Network holds the resource.
+	// The resource is moved to the new network when the reference changes.
+	// +optional
+	NetworkRef *NBResourceNetworkRef `json:"networkRef,omitempty"`
 	// +kubebuilder:validation:MinLength=1
 	Address string `json:"address"`
+	// Description of the NetBird network resource, defaults to "Created by kubernetes-operator"
+	// +optional
+	Description string `json:"description,omitempty"`
 	// +kubebuilder:validation:items:MinLength=1
 	Groups []string `json:"groups"`
 	// +optional
@@ -27,22 +37,49 @@ type NBResourceSpec struct {
 	TCPPorts []int32 `json:"tcpPorts,omitempty"`
 	// +optional
 	UDPPorts []int32 `json:"udpPorts,omitempty"`
+	// Enabled whether the NetBird network resource is enabled.
+	// Disabling it keeps the resource, its groups and policies in place.
+	// +optional
+	// +kubebuilder:default=true
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// NBResourceNetworkRef references an NBRoutingPeer.
+type NBResourceNetworkRef struct {
+	// Namespace of the NBRoutingPeer, defaults to the NBResource namespace
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// Name of the NBRoutingPeer
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
 }
 
 // Equal returns if NBResource is equal to this one
 func (a NBResourceSpec) Equal(b NBResourceSpec) bool {
 	return a.Name == b.Name &&
 		a.NetworkID == b.NetworkID &&
+		(a.NetworkRef == nil) == (b.NetworkRef == nil) &&
+		(a.NetworkRef == nil || *a.NetworkRef == *b.NetworkRef) &&
 		a.Address == b.Address &&
+		a.Description == b.Description &&
 		util.Equivalent(a.Groups, b.Groups) &&
 		a.PolicyName == b.PolicyName &&
 		util.Equivalent(a.TCPPorts, b.TCPPorts) &&
 		util.Equivalent(a.UDPPorts, b.UDPPorts) &&
-		util.Equivalent(a.PolicySourceGroups, b.PolicySourceGroups)
+		util.Equivalent(a.PolicySourceGroups, b.PolicySourceGroups) &&
+		a.IsEnabled() == b.IsEnabled()
+}
+
+// IsEnabled returns if the network resource should be enabled, defaulting to true
+func (a NBResourceSpec) IsEnabled() bool {
+	return a.Enabled == nil || *a.Enabled
 }
 
 // NBResourceStatus defines the observed state of NBResource.
 type NBResourceStatus struct {
+	// NetworkID ID of the NetBird Network the resource is created in
+	// +optional
+	NetworkID *string `json:"networkID,omitempty"`
 	// +optional
 	NetworkResourceID *string `json:"networkResourceID,omitempty"`
 	// +optional
@@ -65,7 +102,9 @@ type NBResourceStatus struct {
 
 // Equal returns if NBResourceStatus is equal to this one
 func (a NBResourceStatus) Equal(b NBResourceStatus) bool {
-	return a.NetworkResourceID == b.NetworkResourceID &&
+	return (a.NetworkID == nil) == (b.NetworkID == nil) &&
+		(a.NetworkID == nil || *a.NetworkID == *b.NetworkID) &&
+		a.NetworkResourceID == b.NetworkResourceID &&
 		a.PolicyName == b.PolicyName &&
 		util.Equivalent(a.TCPPorts, b.TCPPorts) &&
 		util.Equivalent(a.UDPPorts, b.UDPPorts) &&
@@ -88,6 +127,14 @@ type NBResource struct {
 	Status NBResourceStatus `json:"status,omitempty"`
 }
 
+// ResolvedNetworkID ID of the NetBird Network holding the resource, empty if a networkRef isn't resolved yet
+func (a NBResource) ResolvedNetworkID() string {
+	if a.Status.NetworkID != nil {
+		return *a.Status.NetworkID
+	}
+	return a.Spec.NetworkID
+}
+
 // +kubebuilder:object:root=true
 
 // NBResourceList contains a list of NBResource.
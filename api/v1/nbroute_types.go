@@ -0,0 +1,103 @@
+package v1
+
+import (
+	"github.com/netbirdio/kubernetes-operator/internal/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NBRouteSpec defines the desired state of NBRoute.
+// +kubebuilder:validation:XValidation:rule="has(self.network) != has(self.domains)",message="exactly one of network or domains must be set"
+// +kubebuilder:validation:XValidation:rule="has(self.peer) != has(self.peerGroups)",message="exactly one of peer or peerGroups must be set"
+type NBRouteSpec struct {
+	// NetworkIdentifier route network identifier, routes with the same identifier are highly available routes
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=40
+	NetworkIdentifier string `json:"networkIdentifier"`
+	// +optional
+	Description string `json:"description,omitempty"`
+	// Network range in CIDR format
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	Network string `json:"network,omitempty"`
+	// Domains list to be dynamically resolved
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=32
+	// +kubebuilder:validation:items:MinLength=1
+	Domains []string `json:"domains,omitempty"`
+	// KeepRoute keep routes of domains after they no longer resolve to an IP
+	// +optional
+	KeepRoute bool `json:"keepRoute,omitempty"`
+	// Peer ID of routing peer
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	Peer string `json:"peer,omitempty"`
+	// PeerGroups names of groups containing routing peers
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:items:MinLength=1
+	PeerGroups []string `json:"peerGroups,omitempty"`
+	// Groups names of distribution groups receiving the route
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:items:MinLength=1
+	Groups []string `json:"groups"`
+	// AccessControlGroups names of groups used for route access control
+	// +optional
+	// +kubebuilder:validation:items:MinLength=1
+	AccessControlGroups []string `json:"accessControlGroups,omitempty"`
+	// Metric route metric, lower metric has higher priority
+	// +optional
+	// +kubebuilder:default=9999
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=9999
+	Metric int `json:"metric,omitempty"`
+	// +optional
+	// +kubebuilder:default=true
+	Masquerade *bool `json:"masquerade,omitempty"`
+	// +optional
+	// +kubebuilder:default=true
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// NBRouteStatus defines the observed state of NBRoute.
+type NBRouteStatus struct {
+	// +optional
+	RouteID *string `json:"routeID,omitempty"`
+	// +optional
+	Conditions []NBCondition `json:"conditions,omitempty"`
+}
+
+// Equal returns if NBRouteStatus is equal to this one
+func (a NBRouteStatus) Equal(b NBRouteStatus) bool {
+	return a.RouteID == b.RouteID &&
+		util.Equivalent(a.Conditions, b.Conditions)
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Network",type=string,JSONPath=`.spec.network`
+// +kubebuilder:printcolumn:name="Route ID",type=string,JSONPath=`.status.routeID`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// NBRoute is the Schema for the nbroutes API.
+type NBRoute struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NBRouteSpec   `json:"spec,omitempty"`
+	Status NBRouteStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NBRouteList contains a list of NBRoute.
+type NBRouteList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NBRoute `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&NBRoute{}, &NBRouteList{})
+}
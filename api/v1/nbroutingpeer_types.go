@@ -1,13 +1,103 @@
 package v1
 
 import (
+	"slices"
+
 	"github.com/netbirdio/kubernetes-operator/internal/util"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+const (
+	// NBRoutingPeerReady indicates whether routing peers are available and connected.
+	NBRoutingPeerReady NBConditionType = "Ready"
+	// NBRoutingPeerDeploymentAvailable indicates whether the routing peer Deployment has available replicas.
+	NBRoutingPeerDeploymentAvailable NBConditionType = "DeploymentAvailable"
+	// NBRoutingPeerPeersConnected indicates whether routing peers are connected to NetBird management.
+	NBRoutingPeerPeersConnected NBConditionType = "PeersConnected"
+	// NBRoutingPeerClusterNameConflict indicates whether peers of another cluster with the same cluster name
+	// register in the routing peer group.
+	NBRoutingPeerClusterNameConflict NBConditionType = "ClusterNameConflict"
+
+	// NBRoutingPeerWorkloadDeployment runs routing peers in a Deployment.
+	NBRoutingPeerWorkloadDeployment = "Deployment"
+	// NBRoutingPeerWorkloadStatefulSet runs routing peers in a StatefulSet with persistent client state.
+	NBRoutingPeerWorkloadStatefulSet = "StatefulSet"
+
+	// NBRoutingPeerUnhealthyNone keeps the NetBird router as is while routing peers are unavailable.
+	NBRoutingPeerUnhealthyNone = "None"
+	// NBRoutingPeerUnhealthyDisable disables the NetBird router while routing peers are unavailable.
+	NBRoutingPeerUnhealthyDisable = "Disable"
+	// NBRoutingPeerUnhealthyRaiseMetric raises the NetBird router metric to NBRouterMaxMetric while routing peers are unavailable.
+	NBRoutingPeerUnhealthyRaiseMetric = "RaiseMetric"
+	// NBRouterMaxMetric highest, and lowest priority, NetBird router metric.
+	NBRouterMaxMetric = 9999
+
+	// NBRoutingPeerTopologyLabel label referencing the topology NBRoutingPeer of per-zone routing peers and their pods.
+	NBRoutingPeerTopologyLabel = "netbird.io/topology"
+)
+
 // NBRoutingPeerSpec defines the desired state of NBRoutingPeer.
+// +kubebuilder:validation:XValidation:rule="has(self.networkID) == has(oldSelf.networkID)",message="networkID can't be added or removed"
+// +kubebuilder:validation:XValidation:rule="!has(self.networkID) || !has(self.networkName)",message="networkName can't be set with networkID"
+// +kubebuilder:validation:XValidation:rule="!has(self.workloadType) || self.workloadType != 'StatefulSet' || (has(self.ephemeral) && !self.ephemeral)",message="StatefulSet routing peers must set ephemeral to false"
 type NBRoutingPeerSpec struct {
+	// NetworkID ID of an existing NetBird Network to attach the routing peer to.
+	// The network is adopted and never modified or deleted by the operator.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// +kubebuilder:validation:MinLength=1
+	NetworkID string `json:"networkID,omitempty"`
+	// NetworkName name of the NetBird Network, defaults to the cluster name.
+	// An existing network with this name is adopted, otherwise a network is created.
+	// Created networks are renamed in place when this value changes.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	NetworkName string `json:"networkName,omitempty"`
+	// NetworkDescription description of created NetBird Network
+	// +optional
+	NetworkDescription *string `json:"networkDescription,omitempty"`
+	// Ephemeral whether routing peers register as ephemeral peers.
+	// Non-ephemeral peers survive management outages, stale peers are removed by the operator instead.
+	// +optional
+	// +kubebuilder:default=true
+	Ephemeral *bool `json:"ephemeral,omitempty"`
+	// WorkloadType workload running routing peers.
+	// StatefulSet routing peers persist their client state per replica, keeping their peer ID and IP across restarts.
+	// +optional
+	// +kubebuilder:default=Deployment
+	// +kubebuilder:validation:Enum=Deployment;StatefulSet
+	WorkloadType string `json:"workloadType,omitempty"`
+	// StatefulSet persistent storage of StatefulSet routing peers
+	// +optional
+	StatefulSet *NBRoutingPeerStatefulSet `json:"statefulSet,omitempty"`
+	// RouterMetric metric of the NetBird Network router, lower metric has higher priority
+	// +optional
+	// +kubebuilder:default=9999
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=9999
+	RouterMetric int `json:"routerMetric,omitempty"`
+	// UnhealthyAction action applied to the NetBird Network router while no routing peer pods are available,
+	// letting clients fail over to routers of other clusters.
+	// RaiseMetric requires a routerMetric, or zone metrics with topology, lower than 9999.
+	// +optional
+	// +kubebuilder:default=None
+	// +kubebuilder:validation:Enum=None;Disable;RaiseMetric
+	UnhealthyAction string `json:"unhealthyAction,omitempty"`
+	// Metrics expose routing peer client metrics through an exporter sidecar
+	// +optional
+	Metrics *NBRoutingPeerMetrics `json:"metrics,omitempty"`
+	// ClusterCIDRs expose cluster IP ranges as NetBird Network resources through the routing peer
+	// +optional
+	ClusterCIDRs *NBRoutingPeerClusterCIDRs `json:"clusterCIDRs,omitempty"`
+	// ExitNode route internet traffic of NetBird peers through the routing peer
+	// +optional
+	ExitNode *NBRoutingPeerExitNode `json:"exitNode,omitempty"`
+	// Topology run a routing peer per zone instead, each with its own group and network router sharing the network.
+	// Per-zone routing peers are managed as NBRoutingPeers named "<name>-<zone>", inheriting this spec.
+	// +optional
+	Topology *NBRoutingPeerTopology `json:"topology,omitempty"`
 	// +optional
 	Replicas *int32 `json:"replicas"`
 	// +optional
@@ -26,28 +116,203 @@ type NBRoutingPeerSpec struct {
 	VolumeMounts []corev1.VolumeMount `json:"volumeMounts"`
 }
 
+// NBRoutingPeerStatefulSet defines the per-replica PersistentVolumeClaim holding the NetBird client state.
+type NBRoutingPeerStatefulSet struct {
+	// StorageClassName storage class of client state volumes, defaults to the cluster default storage class
+	// +optional
+	StorageClassName *string `json:"storageClassName,omitempty"`
+	// Size requested size of client state volumes
+	// +optional
+	// +kubebuilder:default="10Mi"
+	Size resource.Quantity `json:"size,omitempty"`
+}
+
+// NBRoutingPeerMetrics defines the metrics exporter sidecar of routing peer pods.
+// The sidecar shares the NetBird daemon socket through NB_DAEMON_ADDR, and is expected to
+// expose Prometheus metrics parsed from `netbird status --json` on /metrics.
+type NBRoutingPeerMetrics struct {
+	// Image metrics exporter sidecar image
+	// +kubebuilder:validation:MinLength=1
+	Image string `json:"image"`
+	// Port metrics port exposed by the exporter
+	// +optional
+	// +kubebuilder:default=9090
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port,omitempty"`
+	// Resources metrics exporter sidecar resources
+	// +optional
+	Resources corev1.ResourceRequirements `json:"resources"`
+	// ServiceMonitor create a prometheus-operator ServiceMonitor for the metrics Service
+	// +optional
+	ServiceMonitor *NBRoutingPeerServiceMonitor `json:"serviceMonitor,omitempty"`
+}
+
+// NBRoutingPeerServiceMonitor defines the ServiceMonitor created for routing peer metrics.
+type NBRoutingPeerServiceMonitor struct {
+	// Interval scrape interval
+	// +optional
+	Interval string `json:"interval,omitempty"`
+	// Labels additional ServiceMonitor labels, useful for Prometheus serviceMonitorSelector
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// NBRoutingPeerClusterCIDRs defines which cluster IP ranges are exposed as NetBird Network resources.
+// Ranges are discovered from the cluster and kept in sync as nodes join or leave.
+type NBRoutingPeerClusterCIDRs struct {
+	// Groups names of groups assigned to the IP range resources
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:items:MinLength=1
+	Groups []string `json:"groups"`
+	// Pods expose node pod CIDRs
+	// +optional
+	Pods bool `json:"pods,omitempty"`
+	// Services expose the service CIDR
+	// +optional
+	Services bool `json:"services,omitempty"`
+	// Nodes expose node internal IPs
+	// +optional
+	Nodes bool `json:"nodes,omitempty"`
+	// ServiceCIDRs service CIDRs to expose, discovered from ServiceCIDR objects (Kubernetes 1.31+) if not set
+	// +optional
+	// +kubebuilder:validation:items:MinLength=1
+	ServiceCIDRs []string `json:"serviceCIDRs,omitempty"`
+}
+
+// NBRoutingPeerExitNode defines a default route (0.0.0.0/0) through routing peers.
+// The route is managed through an NBRoute named "<NBRoutingPeer name>-exit-node".
+type NBRoutingPeerExitNode struct {
+	// Groups names of distribution groups routing internet traffic through the routing peer
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:items:MinLength=1
+	Groups []string `json:"groups"`
+	// Masquerade traffic with the routing peer pod IP, and in turn the cluster egress IP
+	// +optional
+	// +kubebuilder:default=true
+	Masquerade *bool `json:"masquerade,omitempty"`
+	// Metric route metric, lower metric has higher priority
+	// +optional
+	// +kubebuilder:default=9999
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=9999
+	Metric int `json:"metric,omitempty"`
+}
+
+// NBRoutingPeerTopology defines the zones running routing peers, and the metric ordering of their network routers.
+type NBRoutingPeerTopology struct {
+	// TopologyKey node label holding zone names
+	// +optional
+	// +kubebuilder:default="topology.kubernetes.io/zone"
+	// +kubebuilder:validation:MinLength=1
+	TopologyKey string `json:"topologyKey,omitempty"`
+	// Zones zones running routing peers
+	// +kubebuilder:validation:MinItems=1
+	// +listType=map
+	// +listMapKey=name
+	Zones []NBRoutingPeerZone `json:"zones"`
+}
+
+// NBRoutingPeerZone defines the routing peer of a single zone.
+type NBRoutingPeerZone struct {
+	// Name value of the topology key node label
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// Metric metric of the zone network router, lower metric has higher priority
+	// +optional
+	// +kubebuilder:default=9999
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=9999
+	Metric int `json:"metric,omitempty"`
+}
+
 // NBRoutingPeerStatus defines the observed state of NBRoutingPeer.
 type NBRoutingPeerStatus struct {
 	// +optional
 	NetworkID *string `json:"networkID"`
+	// NetworkAdopted whether the NetBird Network existed before and is not managed by the operator
+	// +optional
+	NetworkAdopted bool `json:"networkAdopted,omitempty"`
 	// +optional
 	SetupKeyID *string `json:"setupKeyID"`
 	// +optional
 	RouterID *string `json:"routerID"`
+	// RouterDegraded whether unhealthyAction is currently applied to the NetBird Network router
+	// +optional
+	RouterDegraded bool `json:"routerDegraded,omitempty"`
 	// +optional
 	Conditions []NBCondition `json:"conditions,omitempty"`
+	// Replicas desired number of routing peer pods
+	// +optional
+	Replicas int32 `json:"replicas,omitempty"`
+	// ReadyReplicas number of routing peer pods ready
+	// +optional
+	ReadyReplicas int32 `json:"readyReplicas,omitempty"`
+	// ConnectedPeers number of peers in the routing peer group connected to NetBird management
+	// +optional
+	ConnectedPeers int32 `json:"connectedPeers,omitempty"`
+	// Peers NetBird peers currently registered in the routing peer group
+	// +optional
+	Peers []NBRoutingPeerPeer `json:"peers,omitempty"`
+	// ClusterCIDRs cluster IP ranges currently exposed as NetBird Network resources
+	// +optional
+	ClusterCIDRs []string `json:"clusterCIDRs,omitempty"`
+}
+
+// NBRoutingPeerPeer defines a NetBird peer registered by a routing peer pod.
+type NBRoutingPeerPeer struct {
+	// ID NetBird peer ID
+	ID string `json:"id"`
+	// Hostname peer hostname, matches the routing peer pod name
+	// +optional
+	Hostname string `json:"hostname,omitempty"`
+	// Ordinal StatefulSet ordinal of the routing peer pod
+	// +optional
+	Ordinal *int32 `json:"ordinal,omitempty"`
+	// IP NetBird IP of the peer
+	// +optional
+	IP string `json:"ip,omitempty"`
+	// Connected whether the peer is connected to NetBird management
+	Connected bool `json:"connected"`
+	// LastSeen last time the peer connected to NetBird management
+	// +optional
+	LastSeen metav1.Time `json:"lastSeen,omitempty"`
+}
+
+// Equal returns if NBRoutingPeerPeer is equal to this one
+func (a NBRoutingPeerPeer) Equal(b NBRoutingPeerPeer) bool {
+	return a.ID == b.ID &&
+		a.Hostname == b.Hostname &&
+		(a.Ordinal == nil) == (b.Ordinal == nil) &&
+		(a.Ordinal == nil || *a.Ordinal == *b.Ordinal) &&
+		a.IP == b.IP &&
+		a.Connected == b.Connected &&
+		a.LastSeen.Equal(&b.LastSeen)
 }
 
 // Equal returns if NBRoutingPeerStatus is equal to this one
 func (a NBRoutingPeerStatus) Equal(b NBRoutingPeerStatus) bool {
 	return a.NetworkID == b.NetworkID &&
+		a.NetworkAdopted == b.NetworkAdopted &&
 		a.SetupKeyID == b.SetupKeyID &&
 		a.RouterID == b.RouterID &&
-		util.Equivalent(a.Conditions, b.Conditions)
+		a.RouterDegraded == b.RouterDegraded &&
+		util.Equivalent(a.Conditions, b.Conditions) &&
+		a.Replicas == b.Replicas &&
+		a.ReadyReplicas == b.ReadyReplicas &&
+		a.ConnectedPeers == b.ConnectedPeers &&
+		slices.EqualFunc(a.Peers, b.Peers, NBRoutingPeerPeer.Equal) &&
+		util.Equivalent(a.ClusterCIDRs, b.ClusterCIDRs)
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Replicas",type=integer,JSONPath=`.status.replicas`
+// +kubebuilder:printcolumn:name="Ready",type=integer,JSONPath=`.status.readyReplicas`
+// +kubebuilder:printcolumn:name="Connected",type=integer,JSONPath=`.status.connectedPeers`
+// +kubebuilder:printcolumn:name="Available",type=string,JSONPath=`.status.conditions[?(@.type=="DeploymentAvailable")].status`
+// +kubebuilder:printcolumn:name="PeersConnected",type=string,JSONPath=`.status.conditions[?(@.type=="PeersConnected")].status`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 // NBRoutingPeer is the Schema for the nbroutingpeers API.
 type NBRoutingPeer struct {
@@ -0,0 +1,46 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// NBRoutingPeerTemplateLabel label referencing the NBRoutingPeerTemplate an NBRoutingPeer spec is synced from.
+	NBRoutingPeerTemplateLabel = "netbird.io/template"
+	// NBRoutingPeerTemplateSpecAnnotation annotation holding the template spec last applied to an NBRoutingPeer,
+	// telling fields owned by the template apart from fields set on the NBRoutingPeer itself.
+	NBRoutingPeerTemplateSpecAnnotation = "netbird.io/template-spec"
+)
+
+// NBRoutingPeerTemplateSpec defines the desired state of NBRoutingPeerTemplate.
+// +kubebuilder:validation:XValidation:rule="!has(self.template.networkID)",message="networkID can't be set in templates"
+type NBRoutingPeerTemplateSpec struct {
+	// Template spec of NBRoutingPeers created from this template
+	Template NBRoutingPeerSpec `json:"template"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// NBRoutingPeerTemplate is the Schema for the nbroutingpeertemplates API.
+// NBRoutingPeers labeled with netbird.io/template: <name> have fields they don't set kept in sync with the template.
+type NBRoutingPeerTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec NBRoutingPeerTemplateSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NBRoutingPeerTemplateList contains a list of NBRoutingPeerTemplate.
+type NBRoutingPeerTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NBRoutingPeerTemplate `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&NBRoutingPeerTemplate{}, &NBRoutingPeerTemplateList{})
+}
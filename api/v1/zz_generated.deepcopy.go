@@ -6,6 +6,7 @@ package v1
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -26,6 +27,138 @@ func (in *NBCondition) DeepCopy() *NBCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NBExposure) DeepCopyInto(out *NBExposure) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NBExposure.
+func (in *NBExposure) DeepCopy() *NBExposure {
+	if in == nil {
+		return nil
+	}
+	out := new(NBExposure)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NBExposure) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NBExposureList) DeepCopyInto(out *NBExposureList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NBExposure, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NBExposureList.
+func (in *NBExposureList) DeepCopy() *NBExposureList {
+	if in == nil {
+		return nil
+	}
+	out := new(NBExposureList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NBExposureList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NBExposureSpec) DeepCopyInto(out *NBExposureSpec) {
+	*out = *in
+	in.ServiceSelector.DeepCopyInto(&out.ServiceSelector)
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NetworkRef != nil {
+		in, out := &in.NetworkRef, &out.NetworkRef
+		*out = new(NBResourceNetworkRef)
+		**out = **in
+	}
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PolicyPorts != nil {
+		in, out := &in.PolicyPorts, &out.PolicyPorts
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	if in.PolicySourceGroups != nil {
+		in, out := &in.PolicySourceGroups, &out.PolicySourceGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NBExposureSpec.
+func (in *NBExposureSpec) DeepCopy() *NBExposureSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NBExposureSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NBExposureStatus) DeepCopyInto(out *NBExposureStatus) {
+	*out = *in
+	if in.MatchedServices != nil {
+		in, out := &in.MatchedServices, &out.MatchedServices
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]NBCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NBExposureStatus.
+func (in *NBExposureStatus) DeepCopy() *NBExposureStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NBExposureStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NBGroup) DeepCopyInto(out *NBGroup) {
 	*out = *in
@@ -321,9 +454,29 @@ func (in *NBResourceList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NBResourceNetworkRef) DeepCopyInto(out *NBResourceNetworkRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NBResourceNetworkRef.
+func (in *NBResourceNetworkRef) DeepCopy() *NBResourceNetworkRef {
+	if in == nil {
+		return nil
+	}
+	out := new(NBResourceNetworkRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NBResourceSpec) DeepCopyInto(out *NBResourceSpec) {
 	*out = *in
+	if in.NetworkRef != nil {
+		in, out := &in.NetworkRef, &out.NetworkRef
+		*out = new(NBResourceNetworkRef)
+		**out = **in
+	}
 	if in.Groups != nil {
 		in, out := &in.Groups, &out.Groups
 		*out = make([]string, len(*in))
@@ -351,6 +504,11 @@ func (in *NBResourceSpec) DeepCopyInto(out *NBResourceSpec) {
 		*out = make([]int32, len(*in))
 		copy(*out, *in)
 	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NBResourceSpec.
@@ -366,6 +524,11 @@ func (in *NBResourceSpec) DeepCopy() *NBResourceSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NBResourceStatus) DeepCopyInto(out *NBResourceStatus) {
 	*out = *in
+	if in.NetworkID != nil {
+		in, out := &in.NetworkID, &out.NetworkID
+		*out = new(string)
+		**out = **in
+	}
 	if in.NetworkResourceID != nil {
 		in, out := &in.NetworkResourceID, &out.NetworkResourceID
 		*out = new(string)
@@ -429,6 +592,137 @@ func (in *NBResourceStatus) DeepCopy() *NBResourceStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NBRoute) DeepCopyInto(out *NBRoute) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NBRoute.
+func (in *NBRoute) DeepCopy() *NBRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(NBRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NBRoute) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NBRouteList) DeepCopyInto(out *NBRouteList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NBRoute, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NBRouteList.
+func (in *NBRouteList) DeepCopy() *NBRouteList {
+	if in == nil {
+		return nil
+	}
+	out := new(NBRouteList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NBRouteList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NBRouteSpec) DeepCopyInto(out *NBRouteSpec) {
+	*out = *in
+	if in.Domains != nil {
+		in, out := &in.Domains, &out.Domains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PeerGroups != nil {
+		in, out := &in.PeerGroups, &out.PeerGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AccessControlGroups != nil {
+		in, out := &in.AccessControlGroups, &out.AccessControlGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Masquerade != nil {
+		in, out := &in.Masquerade, &out.Masquerade
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NBRouteSpec.
+func (in *NBRouteSpec) DeepCopy() *NBRouteSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NBRouteSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NBRouteStatus) DeepCopyInto(out *NBRouteStatus) {
+	*out = *in
+	if in.RouteID != nil {
+		in, out := &in.RouteID, &out.RouteID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]NBCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NBRouteStatus.
+func (in *NBRouteStatus) DeepCopy() *NBRouteStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NBRouteStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NBRoutingPeer) DeepCopyInto(out *NBRoutingPeer) {
 	*out = *in
@@ -456,6 +750,56 @@ func (in *NBRoutingPeer) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NBRoutingPeerClusterCIDRs) DeepCopyInto(out *NBRoutingPeerClusterCIDRs) {
+	*out = *in
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ServiceCIDRs != nil {
+		in, out := &in.ServiceCIDRs, &out.ServiceCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NBRoutingPeerClusterCIDRs.
+func (in *NBRoutingPeerClusterCIDRs) DeepCopy() *NBRoutingPeerClusterCIDRs {
+	if in == nil {
+		return nil
+	}
+	out := new(NBRoutingPeerClusterCIDRs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NBRoutingPeerExitNode) DeepCopyInto(out *NBRoutingPeerExitNode) {
+	*out = *in
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Masquerade != nil {
+		in, out := &in.Masquerade, &out.Masquerade
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NBRoutingPeerExitNode.
+func (in *NBRoutingPeerExitNode) DeepCopy() *NBRoutingPeerExitNode {
+	if in == nil {
+		return nil
+	}
+	out := new(NBRoutingPeerExitNode)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NBRoutingPeerList) DeepCopyInto(out *NBRoutingPeerList) {
 	*out = *in
@@ -488,9 +832,108 @@ func (in *NBRoutingPeerList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NBRoutingPeerMetrics) DeepCopyInto(out *NBRoutingPeerMetrics) {
+	*out = *in
+	in.Resources.DeepCopyInto(&out.Resources)
+	if in.ServiceMonitor != nil {
+		in, out := &in.ServiceMonitor, &out.ServiceMonitor
+		*out = new(NBRoutingPeerServiceMonitor)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NBRoutingPeerMetrics.
+func (in *NBRoutingPeerMetrics) DeepCopy() *NBRoutingPeerMetrics {
+	if in == nil {
+		return nil
+	}
+	out := new(NBRoutingPeerMetrics)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NBRoutingPeerPeer) DeepCopyInto(out *NBRoutingPeerPeer) {
+	*out = *in
+	if in.Ordinal != nil {
+		in, out := &in.Ordinal, &out.Ordinal
+		*out = new(int32)
+		**out = **in
+	}
+	in.LastSeen.DeepCopyInto(&out.LastSeen)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NBRoutingPeerPeer.
+func (in *NBRoutingPeerPeer) DeepCopy() *NBRoutingPeerPeer {
+	if in == nil {
+		return nil
+	}
+	out := new(NBRoutingPeerPeer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NBRoutingPeerServiceMonitor) DeepCopyInto(out *NBRoutingPeerServiceMonitor) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NBRoutingPeerServiceMonitor.
+func (in *NBRoutingPeerServiceMonitor) DeepCopy() *NBRoutingPeerServiceMonitor {
+	if in == nil {
+		return nil
+	}
+	out := new(NBRoutingPeerServiceMonitor)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NBRoutingPeerSpec) DeepCopyInto(out *NBRoutingPeerSpec) {
 	*out = *in
+	if in.NetworkDescription != nil {
+		in, out := &in.NetworkDescription, &out.NetworkDescription
+		*out = new(string)
+		**out = **in
+	}
+	if in.Ephemeral != nil {
+		in, out := &in.Ephemeral, &out.Ephemeral
+		*out = new(bool)
+		**out = **in
+	}
+	if in.StatefulSet != nil {
+		in, out := &in.StatefulSet, &out.StatefulSet
+		*out = new(NBRoutingPeerStatefulSet)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = new(NBRoutingPeerMetrics)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClusterCIDRs != nil {
+		in, out := &in.ClusterCIDRs, &out.ClusterCIDRs
+		*out = new(NBRoutingPeerClusterCIDRs)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExitNode != nil {
+		in, out := &in.ExitNode, &out.ExitNode
+		*out = new(NBRoutingPeerExitNode)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Topology != nil {
+		in, out := &in.Topology, &out.Topology
+		*out = new(NBRoutingPeerTopology)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Replicas != nil {
 		in, out := &in.Replicas, &out.Replicas
 		*out = new(int32)
@@ -551,6 +994,27 @@ func (in *NBRoutingPeerSpec) DeepCopy() *NBRoutingPeerSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NBRoutingPeerStatefulSet) DeepCopyInto(out *NBRoutingPeerStatefulSet) {
+	*out = *in
+	if in.StorageClassName != nil {
+		in, out := &in.StorageClassName, &out.StorageClassName
+		*out = new(string)
+		**out = **in
+	}
+	out.Size = in.Size.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NBRoutingPeerStatefulSet.
+func (in *NBRoutingPeerStatefulSet) DeepCopy() *NBRoutingPeerStatefulSet {
+	if in == nil {
+		return nil
+	}
+	out := new(NBRoutingPeerStatefulSet)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NBRoutingPeerStatus) DeepCopyInto(out *NBRoutingPeerStatus) {
 	*out = *in
@@ -576,6 +1040,18 @@ func (in *NBRoutingPeerStatus) DeepCopyInto(out *NBRoutingPeerStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Peers != nil {
+		in, out := &in.Peers, &out.Peers
+		*out = make([]NBRoutingPeerPeer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ClusterCIDRs != nil {
+		in, out := &in.ClusterCIDRs, &out.ClusterCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NBRoutingPeerStatus.
@@ -588,6 +1064,115 @@ func (in *NBRoutingPeerStatus) DeepCopy() *NBRoutingPeerStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NBRoutingPeerTemplate) DeepCopyInto(out *NBRoutingPeerTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NBRoutingPeerTemplate.
+func (in *NBRoutingPeerTemplate) DeepCopy() *NBRoutingPeerTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(NBRoutingPeerTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NBRoutingPeerTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NBRoutingPeerTemplateList) DeepCopyInto(out *NBRoutingPeerTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NBRoutingPeerTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NBRoutingPeerTemplateList.
+func (in *NBRoutingPeerTemplateList) DeepCopy() *NBRoutingPeerTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(NBRoutingPeerTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NBRoutingPeerTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NBRoutingPeerTemplateSpec) DeepCopyInto(out *NBRoutingPeerTemplateSpec) {
+	*out = *in
+	in.Template.DeepCopyInto(&out.Template)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NBRoutingPeerTemplateSpec.
+func (in *NBRoutingPeerTemplateSpec) DeepCopy() *NBRoutingPeerTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NBRoutingPeerTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NBRoutingPeerTopology) DeepCopyInto(out *NBRoutingPeerTopology) {
+	*out = *in
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]NBRoutingPeerZone, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NBRoutingPeerTopology.
+func (in *NBRoutingPeerTopology) DeepCopy() *NBRoutingPeerTopology {
+	if in == nil {
+		return nil
+	}
+	out := new(NBRoutingPeerTopology)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NBRoutingPeerZone) DeepCopyInto(out *NBRoutingPeerZone) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NBRoutingPeerZone.
+func (in *NBRoutingPeerZone) DeepCopy() *NBRoutingPeerZone {
+	if in == nil {
+		return nil
+	}
+	out := new(NBRoutingPeerZone)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NBSetupKey) DeepCopyInto(out *NBSetupKey) {
 	*out = *in
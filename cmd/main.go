@@ -76,6 +76,15 @@ func main() {
 		netbirdAPIKey                string
 		allowAutomaticPolicyCreation bool
 		defaultLabels                string
+		defaultRouterName            string
+		manageNetworkPolicies        bool
+		routingPeerTemplate          string
+		exposeIngresses              bool
+		exposeNamespaces             bool
+		ingressControllerService     string
+		exposeGatewayAPI             bool
+		serviceAddressType           string
+		validateServiceAnnotations   bool
 	)
 	flag.StringVar(&managementURL, "netbird-management-url", "https://api.netbird.io", "Management service URL")
 	flag.StringVar(&clientImage, "netbird-client-image", "netbirdio/netbird:latest", "Image for netbird client container")
@@ -105,6 +114,60 @@ func main() {
 		"",
 		"Default labels used for all resources, in format key=value,key=value",
 	)
+	flag.StringVar(
+		&defaultRouterName,
+		"default-router-name",
+		"router",
+		"Name of the NBRoutingPeer used by exposed Services without netbird.io/router annotation",
+	)
+	flag.BoolVar(
+		&manageNetworkPolicies,
+		"manage-network-policies",
+		false,
+		"Maintain NetworkPolicies restricting routing peer traffic to backends of exposed Services",
+	)
+	flag.StringVar(
+		&routingPeerTemplate,
+		"routing-peer-template",
+		"",
+		"Name of the NBRoutingPeerTemplate applied to NBRoutingPeers created for exposed Services",
+	)
+	flag.BoolVar(
+		&exposeIngresses,
+		"expose-ingresses",
+		false,
+		"Expose hosts of Ingresses with netbird.io/expose annotation through NetBird",
+	)
+	flag.BoolVar(
+		&exposeNamespaces,
+		"expose-namespaces",
+		false,
+		"Expose Services of Namespaces with netbird.io/expose annotation through a wildcard domain",
+	)
+	flag.StringVar(
+		&ingressControllerService,
+		"ingress-controller-service",
+		"",
+		"Ingress controller Service (namespace/name) exposed Ingress hosts resolve to, defaults to Ingress load balancer address",
+	)
+	flag.BoolVar(
+		&exposeGatewayAPI,
+		"expose-gateway-api",
+		false,
+		"Expose hostnames and listener ports of Gateways and Routes with netbird.io/expose annotation through NetBird",
+	)
+	flag.StringVar(
+		&serviceAddressType,
+		"service-address-type",
+		controller.ServiceAddressTypeDNS,
+		"Address exposed Services are registered with, DNS for the cluster DNS name or ClusterIP for the Service ClusterIPs",
+	)
+	flag.BoolVar(
+		&validateServiceAnnotations,
+		"validate-service-annotations",
+		false,
+		"Register a validating webhook rejecting Services with invalid NetBird annotations",
+	)
 
 	// Controller generic flags
 	var (
@@ -137,6 +200,10 @@ func main() {
 	opts.BindFlags(flag.CommandLine)
 	flag.Parse()
 
+	if !strings.EqualFold(serviceAddressType, controller.ServiceAddressTypeDNS) && !strings.EqualFold(serviceAddressType, controller.ServiceAddressTypeClusterIP) {
+		panic(fmt.Errorf("invalid service address type %q, must be %s or %s", serviceAddressType, controller.ServiceAddressTypeDNS, controller.ServiceAddressTypeClusterIP))
+	}
+
 	defaultLabelsMap := make(map[string]string)
 	if defaultLabels != "" {
 		for _, s := range strings.Split(defaultLabels, ",") {
@@ -236,6 +303,8 @@ func main() {
 			ManagementURL:      managementURL,
 			NamespacedNetworks: namespacedNetworks,
 			DefaultLabels:      defaultLabelsMap,
+			DefaultRouterName:  defaultRouterName,
+			Recorder:           mgr.GetEventRecorderFor("nbroutingpeer-controller"),
 		}).SetupWithManager(mgr); err != nil {
 			setupLog.Error(err, "unable to create controller", "controller", "NBRoutingPeer")
 			os.Exit(1)
@@ -248,18 +317,84 @@ func main() {
 		}
 
 		if err = (&controller.ServiceReconciler{
-			Client:              mgr.GetClient(),
-			Scheme:              mgr.GetScheme(),
-			ClusterName:         clusterName,
-			ClusterDNS:          clusterDNS,
-			NamespacedNetworks:  namespacedNetworks,
-			ControllerNamespace: controllerNamespace,
-			DefaultLabels:       defaultLabelsMap,
+			Client:                mgr.GetClient(),
+			Scheme:                mgr.GetScheme(),
+			ClusterName:           clusterName,
+			ClusterDNS:            clusterDNS,
+			NamespacedNetworks:    namespacedNetworks,
+			ControllerNamespace:   controllerNamespace,
+			DefaultLabels:         defaultLabelsMap,
+			DefaultRouterName:     defaultRouterName,
+			ManageNetworkPolicies: manageNetworkPolicies,
+			RoutingPeerTemplate:   routingPeerTemplate,
+			AddressType:           serviceAddressType,
 		}).SetupWithManager(mgr); err != nil {
 			setupLog.Error(err, "unable to create controller", "controller", "Service")
 			os.Exit(1)
 		}
 
+		if exposeNamespaces {
+			if err = (&controller.NamespaceReconciler{
+				Client:              mgr.GetClient(),
+				Scheme:              mgr.GetScheme(),
+				ClusterName:         clusterName,
+				ClusterDNS:          clusterDNS,
+				NamespacedNetworks:  namespacedNetworks,
+				ControllerNamespace: controllerNamespace,
+				DefaultLabels:       defaultLabelsMap,
+				DefaultRouterName:   defaultRouterName,
+				RoutingPeerTemplate: routingPeerTemplate,
+				Recorder:            mgr.GetEventRecorderFor("namespace-controller"),
+			}).SetupWithManager(mgr); err != nil {
+				setupLog.Error(err, "unable to create controller", "controller", "Namespace")
+				os.Exit(1)
+			}
+		}
+
+		if err = (&controller.NBExposureReconciler{
+			Client: mgr.GetClient(),
+			Scheme: mgr.GetScheme(),
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "NBExposure")
+			os.Exit(1)
+		}
+
+		if exposeIngresses {
+			if err = (&controller.IngressReconciler{
+				Client:                   mgr.GetClient(),
+				Scheme:                   mgr.GetScheme(),
+				ClusterName:              clusterName,
+				ClusterDNS:               clusterDNS,
+				NamespacedNetworks:       namespacedNetworks,
+				ControllerNamespace:      controllerNamespace,
+				DefaultLabels:            defaultLabelsMap,
+				DefaultRouterName:        defaultRouterName,
+				IngressControllerService: ingressControllerService,
+			}).SetupWithManager(mgr); err != nil {
+				setupLog.Error(err, "unable to create controller", "controller", "Ingress")
+				os.Exit(1)
+			}
+		}
+
+		if exposeGatewayAPI {
+			for _, kind := range controller.GatewayAPIKinds {
+				if err = (&controller.GatewayAPIReconciler{
+					Client:              mgr.GetClient(),
+					Scheme:              mgr.GetScheme(),
+					Kind:                kind,
+					ClusterName:         clusterName,
+					NamespacedNetworks:  namespacedNetworks,
+					ControllerNamespace: controllerNamespace,
+					DefaultLabels:       defaultLabelsMap,
+					DefaultRouterName:   defaultRouterName,
+					Recorder:            mgr.GetEventRecorderFor(strings.ToLower(kind) + "-controller"),
+				}).SetupWithManager(mgr); err != nil {
+					setupLog.Error(err, "unable to create controller", "controller", kind)
+					os.Exit(1)
+				}
+			}
+		}
+
 		if err = (&controller.NBResourceReconciler{
 			Client:                       mgr.GetClient(),
 			Scheme:                       mgr.GetScheme(),
@@ -283,6 +418,17 @@ func main() {
 			os.Exit(1)
 		}
 
+		if err = (&controller.NBRouteReconciler{
+			Client:        mgr.GetClient(),
+			Scheme:        mgr.GetScheme(),
+			APIKey:        netbirdAPIKey,
+			ManagementURL: managementURL,
+			DefaultLabels: defaultLabelsMap,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "NBRoute")
+			os.Exit(1)
+		}
+
 		if err = (&controller.NBPolicyReconciler{
 			Client:        mgr.GetClient(),
 			Scheme:        mgr.GetScheme(),
@@ -299,6 +445,13 @@ func main() {
 				os.Exit(1)
 			}
 
+			if validateServiceAnnotations {
+				if err = webhookk8siov1.SetupServiceWebhookWithManager(mgr); err != nil {
+					setupLog.Error(err, "unable to create webhook", "webhook", "Service")
+					os.Exit(1)
+				}
+			}
+
 			if err = webhooknetbirdiov1.SetupNBRoutingPeerWebhookWithManager(mgr); err != nil {
 				setupLog.Error(err, "unable to create webhook", "webhook", "NBRoutingPeer")
 				os.Exit(1)
@@ -104,4 +104,77 @@ caCert: {{ $ca.Cert | b64enc }}
 clientCert: {{ $cert.Cert | b64enc }}
 clientKey: {{ $cert.Key | b64enc }}
 {{- end -}}
-{{- end -}}
\ No newline at end of file
+{{- end -}}
+{{/*
+NBRoutingPeer spec rendered from ingress.router values
+*/}}
+{{- define "kubernetes-operator.routingPeerSpec" -}}
+{{- if or (or (or .replicas .resources) (or .labels .annotations)) (or .nodeSelector .tolerations) (or .networkName .networkID .networkDescription (hasKey . "ephemeral") .metrics .clusterCIDRs .exitNode .workloadType .statefulSet .routerMetric .unhealthyAction .topology) }}
+{{- if .networkID }}
+networkID: {{ .networkID | quote }}
+{{- end }}
+{{- if .networkName }}
+networkName: {{ .networkName | quote }}
+{{- end }}
+{{- if .networkDescription }}
+networkDescription: {{ .networkDescription | quote }}
+{{- end }}
+{{- if hasKey . "ephemeral" }}
+ephemeral: {{ .ephemeral }}
+{{- end }}
+{{- if .workloadType }}
+workloadType: {{ .workloadType }}
+{{- end }}
+{{- if .statefulSet }}
+statefulSet:
+  {{- toYaml .statefulSet | nindent 2 }}
+{{- end }}
+{{- if .routerMetric }}
+routerMetric: {{ .routerMetric }}
+{{- end }}
+{{- if .unhealthyAction }}
+unhealthyAction: {{ .unhealthyAction }}
+{{- end }}
+{{- if .metrics }}
+metrics:
+  {{- toYaml .metrics | nindent 2 }}
+{{- end }}
+{{- if .clusterCIDRs }}
+clusterCIDRs:
+  {{- toYaml .clusterCIDRs | nindent 2 }}
+{{- end }}
+{{- if .exitNode }}
+exitNode:
+  {{- toYaml .exitNode | nindent 2 }}
+{{- end }}
+{{- if .topology }}
+topology:
+  {{- toYaml .topology | nindent 2 }}
+{{- end }}
+{{- if .replicas }}
+replicas: {{ .replicas }}
+{{- end }}
+{{- if .resources }}
+resources:
+  {{- toYaml .resources | nindent 2 }}
+{{- end }}
+{{- if .labels }}
+labels:
+  {{- toYaml .labels | nindent 2 }}
+{{- end }}
+{{- if .annotations }}
+annotations:
+  {{- toYaml .annotations | nindent 2 }}
+{{- end }}
+{{- if .nodeSelector }}
+nodeSelector:
+  {{- toYaml .nodeSelector | nindent 2 }}
+{{- end }}
+{{- if .tolerations }}
+tolerations:
+  {{- toYaml .tolerations | nindent 2 }}
+{{- end }}
+{{- else }}
+{}
+{{- end }}
+{{- end }}
@@ -0,0 +1,454 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	netbirdiov1 "github.com/netbirdio/kubernetes-operator/api/v1"
+	"github.com/netbirdio/kubernetes-operator/internal/util"
+)
+
+const (
+	// gatewayAPIKindLabel NBResource label referencing the kind of Gateway API object it was created for
+	gatewayAPIKindLabel = "netbird.io/gateway-api-kind"
+	// gatewayAPINameLabel NBResource label referencing the name of Gateway API object it was created for
+	gatewayAPINameLabel = "netbird.io/gateway-api-name"
+)
+
+var (
+	// GatewayAPIKinds Gateway API kinds exposed through NetBird
+	GatewayAPIKinds = []string{"Gateway", "HTTPRoute", "TLSRoute", "TCPRoute", "UDPRoute"}
+
+	gatewayAPIVersions = map[string]string{
+		"Gateway":   "v1",
+		"HTTPRoute": "v1",
+		"TLSRoute":  "v1alpha2",
+		"TCPRoute":  "v1alpha2",
+		"UDPRoute":  "v1alpha2",
+	}
+
+	// gatewayAPIRouteProtocols listener protocols routes of each kind attach to
+	gatewayAPIRouteProtocols = map[string][]string{
+		"HTTPRoute": {"HTTP", "HTTPS"},
+		"TLSRoute":  {"TLS"},
+		"TCPRoute":  {"TCP"},
+		"UDPRoute":  {"UDP"},
+	}
+)
+
+// gatewayListener Gateway listener exposed through NetBird
+type gatewayListener struct {
+	Name     string
+	Hostname string
+	Port     int32
+	Protocol string
+	Address  string
+}
+
+// GatewayAPIReconciler reconciles Gateway API objects of Kind, exposing their hostnames and listener ports
+type GatewayAPIReconciler struct {
+	client.Client
+	Scheme              *runtime.Scheme
+	Kind                string
+	ClusterName         string
+	NamespacedNetworks  bool
+	ControllerNamespace string
+	DefaultLabels       map[string]string
+	DefaultRouterName   string
+	Recorder            record.EventRecorder
+}
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (r *GatewayAPIReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := ctrl.Log.WithName(r.Kind).WithValues("namespace", req.Namespace, "name", req.Name)
+	logger.Info("Reconciling " + r.Kind)
+
+	obj := unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gatewayAPIGVK(r.Kind))
+	err := r.Get(ctx, req.NamespacedName, &obj)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			logger.Error(errKubernetesAPI, "error getting "+r.Kind, "err", err)
+		}
+		// NBResources are garbage collected through owner references
+		return ctrl.Result{}, nil
+	}
+
+	selector := map[string]string{
+		gatewayAPIKindLabel: strings.ToLower(r.Kind),
+		gatewayAPINameLabel: req.Name,
+	}
+
+	annotations := obj.GetAnnotations()
+	_, shouldExpose := annotations[ServiceExposeAnnotation]
+	if !shouldExpose || obj.GetDeletionTimestamp() != nil {
+		return ctrl.Result{}, syncNBResources(ctx, r.Client, req.Namespace, selector, nil, logger)
+	}
+
+	var listeners []gatewayListener
+	if r.Kind == "Gateway" {
+		listeners = gatewayListeners(obj)
+	} else {
+		listeners, err = r.routeListeners(ctx, obj, logger)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Hostnames exposed, mapped to listeners serving them
+	hosts := make(map[string][]gatewayListener)
+	var hostOrder []string
+	routeHostnames, _, _ := unstructured.NestedStringSlice(obj.Object, "spec", "hostnames")
+	for _, l := range listeners {
+		if l.Address == "" {
+			// Keep NBResources while Gateway address is unknown
+			logger.Info("Gateway address not available", "listener", l.Name)
+			return ctrl.Result{RequeueAfter: 5 * time.Second}, nil
+		}
+
+		listenerHosts := []string{l.Hostname}
+		if len(routeHostnames) > 0 {
+			listenerHosts = nil
+			for _, host := range routeHostnames {
+				if hostnamesIntersect(l.Hostname, host) {
+					listenerHosts = append(listenerHosts, host)
+				}
+			}
+		}
+		for _, host := range listenerHosts {
+			if _, ok := hosts[host]; !ok {
+				hostOrder = append(hostOrder, host)
+			}
+			hosts[host] = append(hosts[host], l)
+		}
+	}
+
+	var desired []netbirdiov1.NBResource
+	for _, host := range hostOrder {
+		hostResources, err := r.hostResources(obj, host, hosts[host], logger)
+		if err != nil {
+			// Other hosts are still exposed, NBResources of host are removed
+			logger.Error(errInvalidValue, "error generating NBResource, skipping host", "host", host, "err", err)
+			r.Recorder.Eventf(&obj, corev1.EventTypeWarning, "InvalidHost", "Host %q not exposed: %v", host, err)
+			continue
+		}
+		desired = append(desired, hostResources...)
+	}
+
+	return ctrl.Result{}, syncNBResources(ctx, r.Client, req.Namespace, selector, desired, logger)
+}
+
+// hostResources desired NBResources exposing host on listeners, one per address of listeners serving host.
+// NBResources of addresses after the first are suffixed with a short hash of the address.
+func (r *GatewayAPIReconciler) hostResources(obj unstructured.Unstructured, host string, listeners []gatewayListener, logger logr.Logger) ([]netbirdiov1.NBResource, error) {
+	var addresses []string
+	for _, l := range listeners {
+		if !slices.Contains(addresses, l.Address) {
+			addresses = append(addresses, l.Address)
+		}
+	}
+
+	var nbResources []netbirdiov1.NBResource
+	for i, address := range addresses {
+		addressListeners := slices.DeleteFunc(slices.Clone(listeners), func(l gatewayListener) bool {
+			return l.Address != address
+		})
+		nbResource, err := r.hostResource(obj, host, addressListeners, logger)
+		if err != nil {
+			return nil, err
+		}
+		if i > 0 {
+			nbResource.Name = hashedResourceName(nbResource.Name, address)
+		}
+		nbResources = append(nbResources, nbResource)
+	}
+	return nbResources, nil
+}
+
+// hostResource desired NBResource exposing host (the Gateway address if empty) on listeners sharing an address
+func (r *GatewayAPIReconciler) hostResource(obj unstructured.Unstructured, host string, listeners []gatewayListener, logger logr.Logger) (netbirdiov1.NBResource, error) {
+	annotations := obj.GetAnnotations()
+
+	labels := make(map[string]string)
+	for k, v := range r.DefaultLabels {
+		labels[k] = v
+	}
+	labels[gatewayAPIKindLabel] = strings.ToLower(r.Kind)
+	labels[gatewayAPINameLabel] = obj.GetName()
+
+	defaultRouterName := r.DefaultRouterName
+	if defaultRouterName == "" {
+		defaultRouterName = "router"
+	}
+	routerRef := routingPeerRef(annotations, r.routerNamespace(obj.GetNamespace()), defaultRouterName)
+
+	name := obj.GetName()
+	resourceName := fmt.Sprintf("%s-%s", obj.GetNamespace(), obj.GetName())
+	if host != "" {
+		name = hostResourceName(obj.GetName(), host)
+		resourceName = host
+	}
+
+	nbResource := netbirdiov1.NBResource{
+		ObjectMeta: v1.ObjectMeta{
+			Name:       name,
+			Namespace:  obj.GetNamespace(),
+			Labels:     labels,
+			Finalizers: []string{"netbird.io/cleanup"},
+			OwnerReferences: []v1.OwnerReference{
+				{
+					APIVersion:         obj.GetAPIVersion(),
+					Kind:               r.Kind,
+					Name:               obj.GetName(),
+					UID:                obj.GetUID(),
+					Controller:         util.Ptr(true),
+					BlockOwnerDeletion: util.Ptr(true),
+				},
+			},
+		},
+		Spec: netbirdiov1.NBResourceSpec{
+			Name: resourceName,
+			NetworkRef: &netbirdiov1.NBResourceNetworkRef{
+				Namespace: routerRef.Namespace,
+				Name:      routerRef.Name,
+			},
+			Address: listeners[0].Address,
+			Groups:  resourceGroups(annotations, fmt.Sprintf("%s-%s-%s", r.ClusterName, obj.GetNamespace(), obj.GetName())),
+			Enabled: resourceEnabled(annotations),
+		},
+	}
+
+	if v, ok := annotations[servicePolicyAnnotation]; ok {
+		nbResource.Spec.PolicyName = v
+		filterProtocols, filterPorts, err := policyFilters(annotations)
+		if err != nil {
+			return nbResource, err
+		}
+
+		applyPolicySources(&nbResource, annotations, logger)
+
+		for _, l := range listeners {
+			if len(filterPorts) > 0 && !util.Contains(filterPorts, l.Port) {
+				continue
+			}
+			if l.Protocol == "UDP" {
+				if (len(filterProtocols) == 0 || util.Contains(filterProtocols, "udp")) && !util.Contains(nbResource.Spec.UDPPorts, l.Port) {
+					nbResource.Spec.UDPPorts = append(nbResource.Spec.UDPPorts, l.Port)
+				}
+				continue
+			}
+			if (len(filterProtocols) == 0 || util.Contains(filterProtocols, "tcp")) && !util.Contains(nbResource.Spec.TCPPorts, l.Port) {
+				nbResource.Spec.TCPPorts = append(nbResource.Spec.TCPPorts, l.Port)
+			}
+		}
+	}
+
+	return nbResource, nil
+}
+
+// routeListeners listeners of parent Gateways route attaches to
+func (r *GatewayAPIReconciler) routeListeners(ctx context.Context, route unstructured.Unstructured, logger logr.Logger) ([]gatewayListener, error) {
+	var listeners []gatewayListener
+	for _, parentRef := range routeParentRefs(route) {
+		gateway := unstructured.Unstructured{}
+		gateway.SetGroupVersionKind(gatewayAPIGVK("Gateway"))
+		err := r.Get(ctx, parentRef.NamespacedName, &gateway)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				logger.Info("parent Gateway not found", "gateway", parentRef.NamespacedName.String())
+				continue
+			}
+			logger.Error(errKubernetesAPI, "error getting Gateway", "err", err)
+			return nil, err
+		}
+
+		for _, l := range gatewayListeners(gateway) {
+			if parentRef.SectionName != "" && l.Name != parentRef.SectionName {
+				continue
+			}
+			if parentRef.Port != 0 && l.Port != parentRef.Port {
+				continue
+			}
+			if !slices.Contains(gatewayAPIRouteProtocols[r.Kind], l.Protocol) {
+				continue
+			}
+			listeners = append(listeners, l)
+		}
+	}
+	return listeners, nil
+}
+
+// routerNamespace namespace of NBRoutingPeers routing traffic to Gateway API objects in namespace
+func (r *GatewayAPIReconciler) routerNamespace(namespace string) string {
+	if r.NamespacedNetworks {
+		return namespace
+	}
+	return r.ControllerNamespace
+}
+
+// gatewayRoutes map func enqueueing routes of Kind attached to a Gateway
+func (r *GatewayAPIReconciler) gatewayRoutes(ctx context.Context, obj client.Object) []reconcile.Request {
+	routeList := unstructured.UnstructuredList{}
+	routeList.SetGroupVersionKind(gatewayAPIGVK(r.Kind + "List"))
+	err := r.List(ctx, &routeList)
+	if err != nil {
+		ctrl.Log.WithName(r.Kind).Error(errKubernetesAPI, "error listing "+r.Kind, "err", err)
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, route := range routeList.Items {
+		for _, parentRef := range routeParentRefs(route) {
+			if parentRef.Namespace == obj.GetNamespace() && parentRef.Name == obj.GetName() {
+				requests = append(requests, reconcile.Request{
+					NamespacedName: types.NamespacedName{Namespace: route.GetNamespace(), Name: route.GetName()},
+				})
+				break
+			}
+		}
+	}
+	return requests
+}
+
+// hostnamesIntersect whether a listener hostname matches a route hostname. Empty listener hostnames match all
+// hostnames, and wildcard hostnames match any hostname with the same suffix, as in Gateway API.
+func hostnamesIntersect(listenerHost, routeHost string) bool {
+	if listenerHost == "" || listenerHost == routeHost {
+		return true
+	}
+	if suffix, ok := strings.CutPrefix(listenerHost, "*"); ok && strings.HasSuffix(routeHost, suffix) {
+		return true
+	}
+	if suffix, ok := strings.CutPrefix(routeHost, "*"); ok && strings.HasSuffix(listenerHost, suffix) {
+		return true
+	}
+	return false
+}
+
+// gatewayParentRef Gateway a route attaches to
+type gatewayParentRef struct {
+	types.NamespacedName
+	SectionName string
+	Port        int32
+}
+
+// routeParentRefs Gateways referenced in route spec.parentRefs
+func routeParentRefs(route unstructured.Unstructured) []gatewayParentRef {
+	parentRefs, _, _ := unstructured.NestedSlice(route.Object, "spec", "parentRefs")
+	var refs []gatewayParentRef
+	for _, p := range parentRefs {
+		parentRef, ok := p.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if group, ok := parentRef["group"].(string); ok && group != gatewayAPIGVK("Gateway").Group {
+			continue
+		}
+		if kind, ok := parentRef["kind"].(string); ok && kind != "Gateway" {
+			continue
+		}
+
+		ref := gatewayParentRef{
+			NamespacedName: types.NamespacedName{Namespace: route.GetNamespace()},
+		}
+		ref.Name, _ = parentRef["name"].(string)
+		if namespace, ok := parentRef["namespace"].(string); ok && namespace != "" {
+			ref.Namespace = namespace
+		}
+		ref.SectionName, _ = parentRef["sectionName"].(string)
+		if port, ok, _ := unstructured.NestedInt64(parentRef, "port"); ok {
+			ref.Port = int32(port)
+		}
+		refs = append(refs, ref)
+	}
+	return refs
+}
+
+// gatewayListeners listeners of Gateway, addressed with the first Gateway status address
+func gatewayListeners(gateway unstructured.Unstructured) []gatewayListener {
+	var address string
+	addresses, _, _ := unstructured.NestedSlice(gateway.Object, "status", "addresses")
+	for _, a := range addresses {
+		gatewayAddress, ok := a.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if value, _, _ := unstructured.NestedString(gatewayAddress, "value"); value != "" {
+			address = value
+			break
+		}
+	}
+
+	var listeners []gatewayListener
+	specListeners, _, _ := unstructured.NestedSlice(gateway.Object, "spec", "listeners")
+	for _, sl := range specListeners {
+		listener, ok := sl.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		l := gatewayListener{Address: address}
+		l.Name, _, _ = unstructured.NestedString(listener, "name")
+		l.Hostname, _, _ = unstructured.NestedString(listener, "hostname")
+		l.Protocol, _, _ = unstructured.NestedString(listener, "protocol")
+		port, _, _ := unstructured.NestedInt64(listener, "port")
+		l.Port = int32(port)
+		listeners = append(listeners, l)
+	}
+	return listeners
+}
+
+// gatewayAPIGVK GroupVersionKind of Gateway API kind
+func gatewayAPIGVK(kind string) schema.GroupVersionKind {
+	return schema.GroupVersionKind{
+		Group:   "gateway.networking.k8s.io",
+		Version: gatewayAPIVersions[strings.TrimSuffix(kind, "List")],
+		Kind:    kind,
+	}
+}
+
+// SetupWithManager sets up the controller with the Manager, skipping kinds whose CRD is not installed.
+func (r *GatewayAPIReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	gvk := gatewayAPIGVK(r.Kind)
+	_, err := mgr.GetRESTMapper().RESTMapping(gvk.GroupKind(), gvk.Version)
+	if meta.IsNoMatchError(err) {
+		ctrl.Log.WithName(r.Kind).Info(r.Kind + " CRD is not installed, skipping")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	builder := ctrl.NewControllerManagedBy(mgr).
+		For(obj).
+		Owns(&netbirdiov1.NBResource{}).
+		Named(strings.ToLower(r.Kind))
+
+	if r.Kind != "Gateway" {
+		gateway := &unstructured.Unstructured{}
+		gateway.SetGroupVersionKind(gatewayAPIGVK("Gateway"))
+		builder = builder.Watches(gateway, handler.EnqueueRequestsFromMapFunc(r.gatewayRoutes))
+	}
+
+	return builder.Complete(r)
+}
@@ -0,0 +1,165 @@
+package controller
+
+import (
+	netbirdiov1 "github.com/netbirdio/kubernetes-operator/api/v1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+var _ = Describe("Gateway API Controller", func() {
+	Context("When parsing Gateway API objects", func() {
+		gateway := unstructured.Unstructured{Object: map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"name":      "gateway",
+				"namespace": "gateways",
+			},
+			"spec": map[string]interface{}{
+				"listeners": []interface{}{
+					map[string]interface{}{
+						"name":     "http",
+						"hostname": "*.example.com",
+						"protocol": "HTTP",
+						"port":     int64(80),
+					},
+					map[string]interface{}{
+						"name":     "dns",
+						"protocol": "UDP",
+						"port":     int64(53),
+					},
+				},
+			},
+			"status": map[string]interface{}{
+				"addresses": []interface{}{
+					map[string]interface{}{
+						"type":  "IPAddress",
+						"value": "10.0.0.1",
+					},
+				},
+			},
+		}}
+		gateway.SetGroupVersionKind(gatewayAPIGVK("Gateway"))
+
+		It("should read Gateway listeners", func() {
+			Expect(gatewayListeners(gateway)).To(Equal([]gatewayListener{
+				{Name: "http", Hostname: "*.example.com", Port: 80, Protocol: "HTTP", Address: "10.0.0.1"},
+				{Name: "dns", Port: 53, Protocol: "UDP", Address: "10.0.0.1"},
+			}))
+		})
+
+		It("should read route parent Gateways", func() {
+			route := unstructured.Unstructured{Object: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"name":      "route",
+					"namespace": "default",
+				},
+				"spec": map[string]interface{}{
+					"parentRefs": []interface{}{
+						map[string]interface{}{
+							"name":        "gateway",
+							"namespace":   "gateways",
+							"sectionName": "http",
+						},
+						map[string]interface{}{
+							"name": "local",
+							"port": int64(8080),
+						},
+						map[string]interface{}{
+							"kind": "Service",
+							"name": "mesh",
+						},
+					},
+				},
+			}}
+
+			Expect(routeParentRefs(route)).To(Equal([]gatewayParentRef{
+				{NamespacedName: types.NamespacedName{Namespace: "gateways", Name: "gateway"}, SectionName: "http"},
+				{NamespacedName: types.NamespacedName{Namespace: "default", Name: "local"}, Port: 8080},
+			}))
+		})
+
+		It("should derive policy ports per listener protocol", func() {
+			r := &GatewayAPIReconciler{
+				Kind:                "Gateway",
+				ClusterName:         "kubernetes",
+				ControllerNamespace: "default",
+			}
+			obj := *gateway.DeepCopy()
+			obj.SetAnnotations(map[string]string{
+				ServiceExposeAnnotation: "true",
+				servicePolicyAnnotation: "default",
+			})
+
+			nbResource, err := r.hostResource(obj, "", gatewayListeners(obj), ctrl.Log)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nbResource.Name).To(Equal("gateway"))
+			Expect(nbResource.Spec.Name).To(Equal("gateways-gateway"))
+			Expect(nbResource.Spec.Address).To(Equal("10.0.0.1"))
+			Expect(nbResource.Spec.Groups).To(ConsistOf("kubernetes-gateways-gateway"))
+			Expect(nbResource.Spec.NetworkRef).To(Equal(&netbirdiov1.NBResourceNetworkRef{Namespace: "default", Name: "router"}))
+			Expect(nbResource.Spec.TCPPorts).To(ConsistOf(int32(80)))
+			Expect(nbResource.Spec.UDPPorts).To(ConsistOf(int32(53)))
+			Expect(nbResource.Labels).To(HaveKeyWithValue(gatewayAPIKindLabel, "gateway"))
+			Expect(nbResource.Spec.IsEnabled()).To(BeTrue())
+		})
+
+		It("should match listener and route hostnames", func() {
+			Expect(hostnamesIntersect("", "web.example.com")).To(BeTrue())
+			Expect(hostnamesIntersect("web.example.com", "web.example.com")).To(BeTrue())
+			Expect(hostnamesIntersect("*.example.com", "web.example.com")).To(BeTrue())
+			Expect(hostnamesIntersect("web.example.com", "*.example.com")).To(BeTrue())
+			Expect(hostnamesIntersect("*.example.com", "example.com")).To(BeFalse())
+			Expect(hostnamesIntersect("api.example.com", "web.example.com")).To(BeFalse())
+		})
+
+		It("should expose host per listener address", func() {
+			r := &GatewayAPIReconciler{
+				Kind:                "HTTPRoute",
+				ClusterName:         "kubernetes",
+				ControllerNamespace: "default",
+			}
+			route := unstructured.Unstructured{Object: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"name":      "route",
+					"namespace": "default",
+					"annotations": map[string]interface{}{
+						ServiceExposeAnnotation: "true",
+						servicePolicyAnnotation: "default",
+					},
+				},
+			}}
+
+			nbResources, err := r.hostResources(route, "web.example.com", []gatewayListener{
+				{Name: "http", Port: 80, Protocol: "HTTP", Address: "10.0.0.1"},
+				{Name: "https", Port: 443, Protocol: "HTTPS", Address: "10.0.0.2"},
+			}, ctrl.Log)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nbResources).To(HaveLen(2))
+			Expect(nbResources[0].Name).To(Equal(hostResourceName("route", "web.example.com")))
+			Expect(nbResources[0].Spec.Address).To(Equal("10.0.0.1"))
+			Expect(nbResources[0].Spec.TCPPorts).To(ConsistOf(int32(80)))
+			Expect(nbResources[1].Name).NotTo(Equal(nbResources[0].Name))
+			Expect(nbResources[1].Spec.Address).To(Equal("10.0.0.2"))
+			Expect(nbResources[1].Spec.TCPPorts).To(ConsistOf(int32(443)))
+		})
+
+		It("should disable NBResource with netbird.io/enabled false", func() {
+			r := &GatewayAPIReconciler{
+				Kind:                "Gateway",
+				ClusterName:         "kubernetes",
+				ControllerNamespace: "default",
+			}
+			obj := *gateway.DeepCopy()
+			obj.SetAnnotations(map[string]string{
+				ServiceExposeAnnotation:  "true",
+				serviceEnabledAnnotation: "false",
+			})
+
+			nbResource, err := r.hostResource(obj, "", gatewayListeners(obj), ctrl.Log)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nbResource.Spec.IsEnabled()).To(BeFalse())
+		})
+	})
+})
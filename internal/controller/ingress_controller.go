@@ -0,0 +1,280 @@
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	netbirdiov1 "github.com/netbirdio/kubernetes-operator/api/v1"
+	"github.com/netbirdio/kubernetes-operator/internal/util"
+)
+
+const (
+	// ingressServiceAnnotation Ingress annotation overriding the ingress controller Service (namespace/name)
+	ingressServiceAnnotation = "netbird.io/ingress-service"
+	// ingressLabel NBResource label referencing the Ingress it was created for
+	ingressLabel = "netbird.io/ingress"
+)
+
+// IngressReconciler reconciles Ingress objects, exposing each host through the ingress controller Service
+type IngressReconciler struct {
+	client.Client
+	Scheme                   *runtime.Scheme
+	ClusterName              string
+	ClusterDNS               string
+	NamespacedNetworks       bool
+	ControllerNamespace      string
+	DefaultLabels            map[string]string
+	DefaultRouterName        string
+	IngressControllerService string
+}
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (r *IngressReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := ctrl.Log.WithName("Ingress").WithValues("namespace", req.Namespace, "name", req.Name)
+	logger.Info("Reconciling Ingress")
+
+	ing := networkingv1.Ingress{}
+	err := r.Get(ctx, req.NamespacedName, &ing)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			logger.Error(errKubernetesAPI, "error getting Ingress", "err", err)
+		}
+		// NBResources are garbage collected through owner references
+		return ctrl.Result{}, nil
+	}
+
+	_, shouldExpose := ing.Annotations[ServiceExposeAnnotation]
+	if !shouldExpose || ing.DeletionTimestamp != nil {
+		return ctrl.Result{}, syncNBResources(ctx, r.Client, req.Namespace, map[string]string{ingressLabel: req.Name}, nil, logger)
+	}
+
+	address := r.ingressAddress(ing, logger)
+	if address == "" {
+		// Keep NBResources while ingress controller address is unknown
+		logger.Info("Ingress controller address not available")
+		return ctrl.Result{RequeueAfter: 5 * time.Second}, nil
+	}
+
+	var desired []netbirdiov1.NBResource
+	for _, host := range ingressHosts(ing) {
+		desired = append(desired, r.ingressResource(ing, host, address, logger))
+	}
+
+	return ctrl.Result{}, syncNBResources(ctx, r.Client, req.Namespace, map[string]string{ingressLabel: req.Name}, desired, logger)
+}
+
+// ingressResource desired NBResource exposing a single Ingress host, resolved by the routing peer.
+// The ingress controller address is recorded in the description.
+func (r *IngressReconciler) ingressResource(ing networkingv1.Ingress, host, address string, logger logr.Logger) netbirdiov1.NBResource {
+	labels := make(map[string]string)
+	for k, v := range r.DefaultLabels {
+		labels[k] = v
+	}
+	labels[ingressLabel] = ing.Name
+
+	defaultRouterName := r.DefaultRouterName
+	if defaultRouterName == "" {
+		defaultRouterName = "router"
+	}
+	routerRef := routingPeerRef(ing.Annotations, r.routerNamespace(ing.Namespace), defaultRouterName)
+
+	nbResource := netbirdiov1.NBResource{
+		ObjectMeta: v1.ObjectMeta{
+			Name:       hostResourceName(ing.Name, host),
+			Namespace:  ing.Namespace,
+			Labels:     labels,
+			Finalizers: []string{"netbird.io/cleanup"},
+			OwnerReferences: []v1.OwnerReference{
+				{
+					APIVersion:         "networking.k8s.io/v1",
+					Kind:               "Ingress",
+					Name:               ing.Name,
+					UID:                ing.UID,
+					Controller:         util.Ptr(true),
+					BlockOwnerDeletion: util.Ptr(true),
+				},
+			},
+		},
+		Spec: netbirdiov1.NBResourceSpec{
+			Name: host,
+			NetworkRef: &netbirdiov1.NBResourceNetworkRef{
+				Namespace: routerRef.Namespace,
+				Name:      routerRef.Name,
+			},
+			Address:     host,
+			Description: address,
+			Groups:      resourceGroups(ing.Annotations, fmt.Sprintf("%s-%s-%s", r.ClusterName, ing.Namespace, ing.Name)),
+			Enabled:     resourceEnabled(ing.Annotations),
+		},
+	}
+	if v, ok := ing.Annotations[serviceDescriptionAnnotation]; ok {
+		nbResource.Spec.Description = v
+	}
+
+	if v, ok := ing.Annotations[servicePolicyAnnotation]; ok {
+		nbResource.Spec.PolicyName = v
+		nbResource.Spec.TCPPorts = []int32{80}
+		if ingressHostTLS(ing, host) {
+			nbResource.Spec.TCPPorts = append(nbResource.Spec.TCPPorts, 443)
+		}
+		applyPolicySources(&nbResource, ing.Annotations, logger)
+	}
+
+	return nbResource
+}
+
+// syncNBResources creates/updates desired NBResources in namespace, deleting NBResources matching selector not in desired
+func syncNBResources(ctx context.Context, c client.Client, namespace string, selector map[string]string, desired []netbirdiov1.NBResource, logger logr.Logger) error {
+	var nbResourceList netbirdiov1.NBResourceList
+	err := c.List(ctx, &nbResourceList, client.InNamespace(namespace), client.MatchingLabels(selector))
+	if err != nil {
+		logger.Error(errKubernetesAPI, "error listing NBResource", "err", err)
+		return err
+	}
+
+	existing := make(map[string]netbirdiov1.NBResource)
+	for _, nbResource := range nbResourceList.Items {
+		existing[nbResource.Name] = nbResource
+	}
+
+	for _, nbResource := range desired {
+		current, ok := existing[nbResource.Name]
+		delete(existing, nbResource.Name)
+
+		if !ok {
+			logger.Info("Creating NBResource", "name", nbResource.Name)
+			err = c.Create(ctx, &nbResource)
+			if err != nil {
+				logger.Error(errKubernetesAPI, "error creating NBResource", "err", err)
+				return err
+			}
+			continue
+		}
+
+		if current.Spec.Equal(nbResource.Spec) && maps.Equal(current.Labels, nbResource.Labels) {
+			continue
+		}
+
+		current.Labels = nbResource.Labels
+		current.OwnerReferences = nbResource.OwnerReferences
+		current.Spec = nbResource.Spec
+		err = c.Update(ctx, &current)
+		if err != nil {
+			logger.Error(errKubernetesAPI, "error updating NBResource", "err", err)
+			return err
+		}
+	}
+
+	for _, nbResource := range existing {
+		if nbResource.DeletionTimestamp != nil {
+			continue
+		}
+		logger.Info("Deleting NBResource", "name", nbResource.Name)
+		err = c.Delete(ctx, &nbResource)
+		if err != nil && !errors.IsNotFound(err) {
+			logger.Error(errKubernetesAPI, "error deleting NBResource", "err", err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ingressAddress address of the ingress controller exposing ing, from the netbird.io/ingress-service annotation,
+// the operator-wide ingress controller Service, or the Ingress load balancer status
+func (r *IngressReconciler) ingressAddress(ing networkingv1.Ingress, logger logr.Logger) string {
+	serviceRef := r.IngressControllerService
+	if v, ok := ing.Annotations[ingressServiceAnnotation]; ok && strings.TrimSpace(v) != "" {
+		serviceRef = strings.TrimSpace(v)
+	}
+
+	if serviceRef != "" {
+		namespace, name, found := strings.Cut(serviceRef, "/")
+		if !found {
+			logger.Error(errInvalidValue, "invalid ingress controller Service, expected namespace/name", "value", serviceRef)
+			return ""
+		}
+		return fmt.Sprintf("%s.%s.%s", name, namespace, r.ClusterDNS)
+	}
+
+	for _, lb := range ing.Status.LoadBalancer.Ingress {
+		if lb.IP != "" {
+			return lb.IP
+		}
+		if lb.Hostname != "" {
+			return lb.Hostname
+		}
+	}
+
+	return ""
+}
+
+// routerNamespace namespace of NBRoutingPeers routing traffic to Ingresses in namespace
+func (r *IngressReconciler) routerNamespace(namespace string) string {
+	if r.NamespacedNetworks {
+		return namespace
+	}
+	return r.ControllerNamespace
+}
+
+// ingressHosts unique hosts of Ingress rules
+func ingressHosts(ing networkingv1.Ingress) []string {
+	var hosts []string
+	for _, rule := range ing.Spec.Rules {
+		if rule.Host != "" && !slices.Contains(hosts, rule.Host) {
+			hosts = append(hosts, rule.Host)
+		}
+	}
+	return hosts
+}
+
+// ingressHostTLS whether host is served over TLS by the Ingress
+func ingressHostTLS(ing networkingv1.Ingress, host string) bool {
+	for _, tls := range ing.Spec.TLS {
+		if slices.Contains(tls.Hosts, host) {
+			return true
+		}
+	}
+	return false
+}
+
+// hostResourceName name of the NBResource exposing host of object name
+func hostResourceName(name, host string) string {
+	return hashedResourceName(name+"-"+strings.ReplaceAll(strings.ReplaceAll(host, "*", "wildcard"), ".", "-"), host)
+}
+
+// hashedResourceName base suffixed with a short hash of key, telling apart keys mapping to the same base.
+// base is truncated to keep the name a valid object name.
+func hashedResourceName(base, key string) string {
+	sum := sha256.Sum256([]byte(key))
+	suffix := "-" + hex.EncodeToString(sum[:])[:8]
+	if maxLength := validation.DNS1123SubdomainMaxLength - len(suffix); len(base) > maxLength {
+		base = strings.TrimRight(base[:maxLength], "-.")
+	}
+	return base + suffix
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *IngressReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&networkingv1.Ingress{}).
+		Owns(&netbirdiov1.NBResource{}).
+		Named("ingress").
+		Complete(r)
+}
@@ -0,0 +1,193 @@
+package controller
+
+import (
+	"strings"
+
+	netbirdiov1 "github.com/netbirdio/kubernetes-operator/api/v1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ = Describe("Ingress Controller", func() {
+	Context("When reconciling a resource", func() {
+		typeNamespacedName := types.NamespacedName{
+			Namespace: "default",
+			Name:      "test-ingress",
+		}
+		var ingress *networkingv1.Ingress
+
+		var controllerReconciler *IngressReconciler
+
+		BeforeEach(func() {
+			ingress = &networkingv1.Ingress{
+				ObjectMeta: v1.ObjectMeta{
+					Name:      "test-ingress",
+					Namespace: "default",
+				},
+				Spec: networkingv1.IngressSpec{
+					Rules: []networkingv1.IngressRule{
+						{Host: "a.example.com"},
+						{Host: "*.example.com"},
+					},
+					TLS: []networkingv1.IngressTLS{
+						{Hosts: []string{"a.example.com"}},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, ingress)).To(Succeed())
+			controllerReconciler = &IngressReconciler{
+				Client:                   k8sClient,
+				Scheme:                   k8sClient.Scheme(),
+				ClusterName:              "kubernetes",
+				ClusterDNS:               "svc.cluster.local",
+				ControllerNamespace:      "default",
+				DefaultLabels:            map[string]string{"dog": "bark"},
+				IngressControllerService: "ingress-nginx/ingress-nginx-controller",
+			}
+		})
+
+		AfterEach(func() {
+			ing := &networkingv1.Ingress{}
+			err := k8sClient.Get(ctx, typeNamespacedName, ing)
+			if !errors.IsNotFound(err) {
+				Expect(k8sClient.Delete(ctx, ing)).To(Succeed())
+			}
+
+			var nbResourceList netbirdiov1.NBResourceList
+			Expect(k8sClient.List(ctx, &nbResourceList, client.InNamespace("default"), client.MatchingLabels{ingressLabel: "test-ingress"})).To(Succeed())
+			for _, nbResource := range nbResourceList.Items {
+				nbResource.Finalizers = nil
+				Expect(k8sClient.Update(ctx, &nbResource)).To(Succeed())
+				err = k8sClient.Delete(ctx, &nbResource)
+				if !errors.IsNotFound(err) {
+					Expect(err).NotTo(HaveOccurred())
+				}
+			}
+		})
+
+		When("Ingress is not exposed", func() {
+			It("should not create NBResources", func() {
+				_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+					NamespacedName: typeNamespacedName,
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				var nbResourceList netbirdiov1.NBResourceList
+				Expect(k8sClient.List(ctx, &nbResourceList, client.InNamespace("default"), client.MatchingLabels{ingressLabel: "test-ingress"})).To(Succeed())
+				Expect(nbResourceList.Items).To(BeEmpty())
+			})
+		})
+
+		When("Ingress is exposed", func() {
+			BeforeEach(func() {
+				ingress.Annotations = map[string]string{
+					ServiceExposeAnnotation: "true",
+					servicePolicyAnnotation: "test",
+				}
+				Expect(k8sClient.Update(ctx, ingress)).To(Succeed())
+			})
+
+			It("should create an NBResource per host", func() {
+				_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+					NamespacedName: typeNamespacedName,
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				nbResource := &netbirdiov1.NBResource{}
+				Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: hostResourceName("test-ingress", "a.example.com")}, nbResource)).To(Succeed())
+				Expect(nbResource.Spec.Name).To(Equal("a.example.com"))
+				Expect(nbResource.Spec.Address).To(Equal("a.example.com"))
+				Expect(nbResource.Spec.Description).To(Equal("ingress-nginx-controller.ingress-nginx.svc.cluster.local"))
+				Expect(nbResource.Spec.Groups).To(ConsistOf("kubernetes-default-test-ingress"))
+				Expect(nbResource.Spec.NetworkRef).To(Equal(&netbirdiov1.NBResourceNetworkRef{Namespace: "default", Name: "router"}))
+				Expect(nbResource.Spec.PolicyName).To(Equal("test"))
+				Expect(nbResource.Spec.TCPPorts).To(ConsistOf(int32(80), int32(443)))
+				Expect(nbResource.Labels).To(HaveKeyWithValue("dog", "bark"))
+
+				Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: hostResourceName("test-ingress", "*.example.com")}, nbResource)).To(Succeed())
+				Expect(nbResource.Spec.Name).To(Equal("*.example.com"))
+				Expect(nbResource.Spec.Address).To(Equal("*.example.com"))
+				Expect(nbResource.Spec.TCPPorts).To(ConsistOf(int32(80)))
+			})
+
+			It("should disable NBResources with netbird.io/enabled false", func() {
+				ingress.Annotations[serviceEnabledAnnotation] = "false"
+				Expect(k8sClient.Update(ctx, ingress)).To(Succeed())
+
+				_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+					NamespacedName: typeNamespacedName,
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				nbResource := &netbirdiov1.NBResource{}
+				Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: hostResourceName("test-ingress", "a.example.com")}, nbResource)).To(Succeed())
+				Expect(nbResource.Spec.IsEnabled()).To(BeFalse())
+			})
+
+			It("should delete NBResources of removed hosts", func() {
+				_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+					NamespacedName: typeNamespacedName,
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(k8sClient.Get(ctx, typeNamespacedName, ingress)).To(Succeed())
+				ingress.Spec.Rules = ingress.Spec.Rules[:1]
+				Expect(k8sClient.Update(ctx, ingress)).To(Succeed())
+
+				_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{
+					NamespacedName: typeNamespacedName,
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				nbResource := &netbirdiov1.NBResource{}
+				Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: hostResourceName("test-ingress", "a.example.com")}, nbResource)).To(Succeed())
+				err = k8sClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: hostResourceName("test-ingress", "*.example.com")}, nbResource)
+				if err == nil {
+					Expect(nbResource.DeletionTimestamp).NotTo(BeNil())
+				} else {
+					Expect(errors.IsNotFound(err)).To(BeTrue())
+				}
+			})
+
+			It("should delete NBResources when annotation is removed", func() {
+				_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+					NamespacedName: typeNamespacedName,
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(k8sClient.Get(ctx, typeNamespacedName, ingress)).To(Succeed())
+				ingress.Annotations = nil
+				Expect(k8sClient.Update(ctx, ingress)).To(Succeed())
+
+				_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{
+					NamespacedName: typeNamespacedName,
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				var nbResourceList netbirdiov1.NBResourceList
+				Expect(k8sClient.List(ctx, &nbResourceList, client.InNamespace("default"), client.MatchingLabels{ingressLabel: "test-ingress"})).To(Succeed())
+				for _, nbResource := range nbResourceList.Items {
+					Expect(nbResource.DeletionTimestamp).NotTo(BeNil())
+				}
+			})
+		})
+	})
+
+	Context("When naming host NBResources", func() {
+		It("should keep names unique and valid", func() {
+			Expect(hostResourceName("test", "a.b-c.example.com")).NotTo(Equal(hostResourceName("test", "a-b.c.example.com")))
+			Expect(hostResourceName("test", "a.example.com")).To(HavePrefix("test-a-example-com-"))
+
+			name := hostResourceName(strings.Repeat("a", 200), strings.Repeat("b.", 60)+"example.com")
+			Expect(len(name)).To(BeNumerically("<=", 253))
+			Expect(validation.IsDNS1123Subdomain(name)).To(BeEmpty())
+		})
+	})
+})
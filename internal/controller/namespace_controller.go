@@ -0,0 +1,174 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	netbirdiov1 "github.com/netbirdio/kubernetes-operator/api/v1"
+	"github.com/netbirdio/kubernetes-operator/internal/util"
+)
+
+const (
+	// namespaceLabel NBResource label referencing the Namespace its wildcard domain was created for
+	namespaceLabel = "netbird.io/namespace"
+	// namespaceResourceName name of the NBResource exposing the wildcard domain of a Namespace
+	namespaceResourceName = "netbird-namespace"
+)
+
+// NamespaceReconciler reconciles Namespace objects, exposing Services of annotated Namespaces through a wildcard domain
+type NamespaceReconciler struct {
+	client.Client
+	Scheme              *runtime.Scheme
+	ClusterName         string
+	ClusterDNS          string
+	NamespacedNetworks  bool
+	ControllerNamespace string
+	DefaultLabels       map[string]string
+	DefaultRouterName   string
+	RoutingPeerTemplate string
+	Recorder            record.EventRecorder
+}
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (r *NamespaceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := ctrl.Log.WithName("Namespace").WithValues("name", req.Name)
+	logger.Info("Reconciling Namespace")
+
+	ns := corev1.Namespace{}
+	err := r.Get(ctx, req.NamespacedName, &ns)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			logger.Error(errKubernetesAPI, "error getting Namespace", "err", err)
+		}
+		return ctrl.Result{}, nil
+	}
+
+	if ns.DeletionTimestamp != nil {
+		// NBResource is deleted alongside the Namespace contents
+		return ctrl.Result{}, nil
+	}
+
+	selector := map[string]string{namespaceLabel: ns.Name}
+	if _, ok := ns.Annotations[ServiceExposeAnnotation]; !ok {
+		return ctrl.Result{}, syncNBResources(ctx, r.Client, ns.Name, selector, nil, logger)
+	}
+
+	nbResource, err := r.namespaceResource(ns, logger)
+	if err != nil {
+		// Keep exposing the wildcard domain without the policy instead of leaving a stale NBResource behind
+		logger.Error(errInvalidValue, "invalid policy annotations, exposing Namespace without policy", "err", err)
+		r.Recorder.Eventf(&ns, corev1.EventTypeWarning, "InvalidPolicy", "Namespace exposed without policy: %v", err)
+	}
+
+	// Default routing peer is created the same way as for exposed Services
+	routerRef := types.NamespacedName{Namespace: nbResource.Spec.NetworkRef.Namespace, Name: nbResource.Spec.NetworkRef.Name}
+	// NBResourceReconciler waits for the network of the NBRoutingPeer
+	_, _, err = ensureRoutingPeer(ctx, r.Client, routerRef, r.defaultRouterRef(ns), r.DefaultLabels, r.RoutingPeerTemplate, logger)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, syncNBResources(ctx, r.Client, ns.Name, selector, []netbirdiov1.NBResource{nbResource}, logger)
+}
+
+// defaultRouterRef default NBRoutingPeer routing traffic to the wildcard domain of ns
+func (r *NamespaceReconciler) defaultRouterRef(ns corev1.Namespace) types.NamespacedName {
+	ref := types.NamespacedName{Namespace: r.ControllerNamespace, Name: r.DefaultRouterName}
+	if r.NamespacedNetworks {
+		ref.Namespace = ns.Name
+	}
+	if ref.Name == "" {
+		ref.Name = "router"
+	}
+	return ref
+}
+
+// namespaceResource desired NBResource exposing *.<namespace>.<ClusterDNS>
+// On invalid policy annotations the NBResource is returned without policy alongside the error
+func (r *NamespaceReconciler) namespaceResource(ns corev1.Namespace, logger logr.Logger) (netbirdiov1.NBResource, error) {
+	labels := make(map[string]string)
+	for k, v := range r.DefaultLabels {
+		labels[k] = v
+	}
+	labels[namespaceLabel] = ns.Name
+
+	defaultRef := r.defaultRouterRef(ns)
+	routerRef := routingPeerRef(ns.Annotations, defaultRef.Namespace, defaultRef.Name)
+
+	resourceName := fmt.Sprintf("%s-wildcard", ns.Name)
+	if v, ok := ns.Annotations[serviceResourceAnnotation]; ok {
+		resourceName = v
+	}
+
+	nbResource := netbirdiov1.NBResource{
+		ObjectMeta: v1.ObjectMeta{
+			Name:       namespaceResourceName,
+			Namespace:  ns.Name,
+			Labels:     labels,
+			Finalizers: []string{"netbird.io/cleanup"},
+			OwnerReferences: []v1.OwnerReference{
+				{
+					APIVersion:         "v1",
+					Kind:               "Namespace",
+					Name:               ns.Name,
+					UID:                ns.UID,
+					Controller:         util.Ptr(true),
+					BlockOwnerDeletion: util.Ptr(true),
+				},
+			},
+		},
+		Spec: netbirdiov1.NBResourceSpec{
+			Name: resourceName,
+			NetworkRef: &netbirdiov1.NBResourceNetworkRef{
+				Namespace: routerRef.Namespace,
+				Name:      routerRef.Name,
+			},
+			Address:     fmt.Sprintf("*.%s.%s", ns.Name, r.ClusterDNS),
+			Description: ns.Annotations[serviceDescriptionAnnotation],
+			Groups:      resourceGroups(ns.Annotations, fmt.Sprintf("%s-%s", r.ClusterName, ns.Name)),
+			Enabled:     resourceEnabled(ns.Annotations),
+		},
+	}
+
+	if v, ok := ns.Annotations[servicePolicyAnnotation]; ok {
+		filterProtocols, filterPorts, err := policyFilters(ns.Annotations)
+		if err != nil {
+			return nbResource, err
+		}
+		if len(filterPorts) == 0 {
+			// Namespaces have no ports of their own to derive policies from
+			return nbResource, fmt.Errorf("%s requires %s on Namespaces", servicePolicyAnnotation, servicePortsAnnotation)
+		}
+
+		nbResource.Spec.PolicyName = v
+		if len(filterProtocols) == 0 || util.Contains(filterProtocols, "tcp") {
+			nbResource.Spec.TCPPorts = filterPorts
+		}
+		if len(filterProtocols) == 0 || util.Contains(filterProtocols, "udp") {
+			nbResource.Spec.UDPPorts = filterPorts
+		}
+		applyPolicySources(&nbResource, ns.Annotations, logger)
+	}
+
+	return nbResource, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *NamespaceReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&corev1.Namespace{}).
+		Owns(&netbirdiov1.NBResource{}).
+		Named("namespace").
+		Complete(r)
+}
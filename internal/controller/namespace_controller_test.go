@@ -0,0 +1,194 @@
+package controller
+
+import (
+	netbirdiov1 "github.com/netbirdio/kubernetes-operator/api/v1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ = Describe("Namespace Controller", func() {
+	Context("When reconciling a resource", func() {
+		typeNamespacedName := types.NamespacedName{
+			Name: "wildcard-test",
+		}
+		resourceNamespacedName := types.NamespacedName{
+			Namespace: "wildcard-test",
+			Name:      namespaceResourceName,
+		}
+		var namespace *corev1.Namespace
+
+		var controllerReconciler *NamespaceReconciler
+
+		BeforeEach(func() {
+			namespace = &corev1.Namespace{}
+			err := k8sClient.Get(ctx, typeNamespacedName, namespace)
+			if errors.IsNotFound(err) {
+				namespace = &corev1.Namespace{
+					ObjectMeta: v1.ObjectMeta{
+						Name: typeNamespacedName.Name,
+					},
+				}
+				Expect(k8sClient.Create(ctx, namespace)).To(Succeed())
+			} else {
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			controllerReconciler = &NamespaceReconciler{
+				Client:              k8sClient,
+				Scheme:              k8sClient.Scheme(),
+				ClusterName:         "kubernetes",
+				ClusterDNS:          "svc.cluster.local",
+				ControllerNamespace: "default",
+				DefaultLabels:       map[string]string{"dog": "bark"},
+				Recorder:            record.NewFakeRecorder(10),
+			}
+		})
+
+		AfterEach(func() {
+			// envtest doesn't run the namespace controller, reset annotations instead of deleting
+			Expect(k8sClient.Get(ctx, typeNamespacedName, namespace)).To(Succeed())
+			namespace.Annotations = nil
+			Expect(k8sClient.Update(ctx, namespace)).To(Succeed())
+
+			nbResource := &netbirdiov1.NBResource{}
+			err := k8sClient.Get(ctx, resourceNamespacedName, nbResource)
+			if !errors.IsNotFound(err) {
+				nbResource.Finalizers = nil
+				Expect(k8sClient.Update(ctx, nbResource)).To(Succeed())
+				err = k8sClient.Delete(ctx, nbResource)
+				if !errors.IsNotFound(err) {
+					Expect(err).NotTo(HaveOccurred())
+				}
+			}
+
+			nbrp := &netbirdiov1.NBRoutingPeer{}
+			err = k8sClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "router"}, nbrp)
+			if !errors.IsNotFound(err) {
+				nbrp.Finalizers = nil
+				Expect(k8sClient.Update(ctx, nbrp)).To(Succeed())
+				err = k8sClient.Delete(ctx, nbrp)
+				if !errors.IsNotFound(err) {
+					Expect(err).NotTo(HaveOccurred())
+				}
+			}
+		})
+
+		When("Namespace is not exposed", func() {
+			It("should not create NBResource", func() {
+				_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+					NamespacedName: typeNamespacedName,
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(errors.IsNotFound(k8sClient.Get(ctx, resourceNamespacedName, &netbirdiov1.NBResource{}))).To(BeTrue())
+			})
+		})
+
+		When("Namespace is exposed", func() {
+			BeforeEach(func() {
+				namespace.Annotations = map[string]string{
+					ServiceExposeAnnotation:   "true",
+					servicePolicyAnnotation:   "default",
+					servicePortsAnnotation:    "80,443",
+					serviceProtocolAnnotation: "tcp",
+				}
+				Expect(k8sClient.Update(ctx, namespace)).To(Succeed())
+			})
+
+			It("should create wildcard NBResource", func() {
+				_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+					NamespacedName: typeNamespacedName,
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				nbResource := &netbirdiov1.NBResource{}
+				Expect(k8sClient.Get(ctx, resourceNamespacedName, nbResource)).To(Succeed())
+				Expect(nbResource.Spec.Address).To(Equal("*.wildcard-test.svc.cluster.local"))
+				Expect(nbResource.Spec.Name).To(Equal("wildcard-test-wildcard"))
+				Expect(nbResource.Spec.Groups).To(ConsistOf("kubernetes-wildcard-test"))
+				Expect(nbResource.Spec.NetworkRef).To(Equal(&netbirdiov1.NBResourceNetworkRef{Namespace: "default", Name: "router"}))
+				Expect(nbResource.Spec.PolicyName).To(Equal("default"))
+				Expect(nbResource.Spec.TCPPorts).To(ConsistOf(int32(80), int32(443)))
+				Expect(nbResource.Spec.UDPPorts).To(BeEmpty())
+				Expect(nbResource.OwnerReferences).To(HaveLen(1))
+				Expect(nbResource.OwnerReferences[0].Kind).To(Equal("Namespace"))
+			})
+
+			It("should create the default NBRoutingPeer", func() {
+				routerNamespacedName := types.NamespacedName{Namespace: "default", Name: "router"}
+				nbrp := &netbirdiov1.NBRoutingPeer{}
+				Expect(errors.IsNotFound(k8sClient.Get(ctx, routerNamespacedName, nbrp))).To(BeTrue())
+
+				_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+					NamespacedName: typeNamespacedName,
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(k8sClient.Get(ctx, routerNamespacedName, nbrp)).To(Succeed())
+				Expect(nbrp.Labels).To(HaveKeyWithValue("dog", "bark"))
+				Expect(k8sClient.Get(ctx, resourceNamespacedName, &netbirdiov1.NBResource{})).To(Succeed())
+			})
+
+			It("should not create NBRoutingPeers selected by annotation", func() {
+				namespace.Annotations[serviceRouterAnnotation] = "internal"
+				Expect(k8sClient.Update(ctx, namespace)).To(Succeed())
+
+				_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+					NamespacedName: typeNamespacedName,
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(errors.IsNotFound(k8sClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "internal"}, &netbirdiov1.NBRoutingPeer{}))).To(BeTrue())
+			})
+
+			It("should drop the policy and record an Event when policy annotations are invalid", func() {
+				_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+					NamespacedName: typeNamespacedName,
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				recorder := record.NewFakeRecorder(10)
+				controllerReconciler.Recorder = recorder
+				delete(namespace.Annotations, servicePortsAnnotation)
+				Expect(k8sClient.Update(ctx, namespace)).To(Succeed())
+
+				_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{
+					NamespacedName: typeNamespacedName,
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				nbResource := &netbirdiov1.NBResource{}
+				Expect(k8sClient.Get(ctx, resourceNamespacedName, nbResource)).To(Succeed())
+				Expect(nbResource.Spec.Address).To(Equal("*.wildcard-test.svc.cluster.local"))
+				Expect(nbResource.Spec.PolicyName).To(BeEmpty())
+				Expect(nbResource.Spec.TCPPorts).To(BeEmpty())
+				Expect(recorder.Events).To(Receive(ContainSubstring("InvalidPolicy")))
+			})
+
+			It("should delete NBResource when annotation is removed", func() {
+				_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+					NamespacedName: typeNamespacedName,
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(k8sClient.Get(ctx, typeNamespacedName, namespace)).To(Succeed())
+				namespace.Annotations = nil
+				Expect(k8sClient.Update(ctx, namespace)).To(Succeed())
+
+				_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{
+					NamespacedName: typeNamespacedName,
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				nbResource := &netbirdiov1.NBResource{}
+				Expect(k8sClient.Get(ctx, resourceNamespacedName, nbResource)).To(Succeed())
+				Expect(nbResource.DeletionTimestamp).NotTo(BeNil())
+			})
+		})
+	})
+})
@@ -0,0 +1,337 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	netbirdiov1 "github.com/netbirdio/kubernetes-operator/api/v1"
+)
+
+// NBExposureReconciler reconciles a NBExposure object, keeping the list of matched Services up to date
+type NBExposureReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (r *NBExposureReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := ctrl.Log.WithName("NBExposure").WithValues("name", req.Name)
+	logger.Info("Reconciling NBExposure")
+
+	var exposure netbirdiov1.NBExposure
+	err := r.Get(ctx, req.NamespacedName, &exposure)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			logger.Error(errKubernetesAPI, "error getting NBExposure", "err", err)
+		}
+		return ctrl.Result{}, nil
+	}
+
+	originalExposure := exposure.DeepCopy()
+
+	err = validateExposureSelectors(exposure)
+	if err != nil {
+		// Keep previously matched Services, invalid selectors match nothing until fixed
+		logger.Error(errInvalidValue, "invalid NBExposure selector", "err", err)
+		exposure.Status.Conditions = keepConditionTimes(exposure.Status.Conditions, netbirdiov1.NBConditionFalse("InvalidSelector", err.Error()))
+	} else {
+		services, err := exposureServices(ctx, r.Client, exposure)
+		if err != nil {
+			logger.Error(errKubernetesAPI, "error listing matched Services", "err", err)
+			return ctrl.Result{}, err
+		}
+
+		matched := make([]string, 0, len(services))
+		for _, svc := range services {
+			matched = append(matched, fmt.Sprintf("%s/%s", svc.Namespace, svc.Name))
+		}
+		slices.Sort(matched)
+
+		exposure.Status.MatchedServices = matched
+		exposure.Status.Conditions = keepConditionTimes(exposure.Status.Conditions, netbirdiov1.NBConditionTrue())
+	}
+
+	if equality.Semantic.DeepEqual(originalExposure.Status, exposure.Status) {
+		return ctrl.Result{}, nil
+	}
+
+	err = r.Client.Status().Update(ctx, &exposure)
+	if err != nil {
+		logger.Error(errKubernetesAPI, "error updating NBExposure status", "err", err)
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// serviceExposureRequests enqueues NBExposures whose Service selector matches Service, or that matched it before.
+// Called for both old and new objects on updates, so label changes reach NBExposures on either side.
+func (r *NBExposureReconciler) serviceExposureRequests(ctx context.Context, obj client.Object) []reconcile.Request {
+	var exposures netbirdiov1.NBExposureList
+	err := r.Client.List(ctx, &exposures)
+	if err != nil {
+		ctrl.Log.WithName("NBExposure").Error(errKubernetesAPI, "error listing NBExposures", "err", err)
+		return nil
+	}
+
+	name := fmt.Sprintf("%s/%s", obj.GetNamespace(), obj.GetName())
+	var requests []reconcile.Request
+	for _, exposure := range exposures.Items {
+		selector, err := v1.LabelSelectorAsSelector(&exposure.Spec.ServiceSelector)
+		if (err == nil && selector.Matches(labels.Set(obj.GetLabels()))) || slices.Contains(exposure.Status.MatchedServices, name) {
+			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: exposure.Name}})
+		}
+	}
+	return requests
+}
+
+// namespaceExposureRequests enqueues NBExposures with a Namespace selector, the only ones affected by Namespace labels
+func (r *NBExposureReconciler) namespaceExposureRequests(ctx context.Context, obj client.Object) []reconcile.Request {
+	var exposures netbirdiov1.NBExposureList
+	err := r.Client.List(ctx, &exposures)
+	if err != nil {
+		ctrl.Log.WithName("NBExposure").Error(errKubernetesAPI, "error listing NBExposures", "err", err)
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, exposure := range exposures.Items {
+		if exposure.Spec.NamespaceSelector != nil {
+			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: exposure.Name}})
+		}
+	}
+	return requests
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *NBExposureReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&netbirdiov1.NBExposure{}).
+		Watches(&corev1.Service{}, handler.EnqueueRequestsFromMapFunc(r.serviceExposureRequests), builder.WithPredicates(predicate.LabelChangedPredicate{})).
+		Watches(&corev1.Namespace{}, handler.EnqueueRequestsFromMapFunc(r.namespaceExposureRequests), builder.WithPredicates(predicate.LabelChangedPredicate{})).
+		Named("nbexposure").
+		Complete(r)
+}
+
+// validateExposureSelectors whether Service and Namespace selectors of NBExposure parse
+func validateExposureSelectors(exposure netbirdiov1.NBExposure) error {
+	if exposure.Spec.NamespaceSelector != nil {
+		_, err := v1.LabelSelectorAsSelector(exposure.Spec.NamespaceSelector)
+		if err != nil {
+			return fmt.Errorf("invalid namespaceSelector: %w", err)
+		}
+	}
+	_, err := v1.LabelSelectorAsSelector(&exposure.Spec.ServiceSelector)
+	if err != nil {
+		return fmt.Errorf("invalid serviceSelector: %w", err)
+	}
+	return nil
+}
+
+// exposureMatches whether NBExposure selects a Service in a Namespace with nsLabels
+func exposureMatches(exposure netbirdiov1.NBExposure, svc corev1.Service, nsLabels map[string]string) (bool, error) {
+	// kubernetes API Service is handled by Helm chart
+	if svc.Namespace == "default" && svc.Name == "kubernetes" {
+		return false, nil
+	}
+
+	if exposure.Spec.NamespaceSelector != nil {
+		selector, err := v1.LabelSelectorAsSelector(exposure.Spec.NamespaceSelector)
+		if err != nil {
+			return false, err
+		}
+		if !selector.Matches(labels.Set(nsLabels)) {
+			return false, nil
+		}
+	}
+
+	selector, err := v1.LabelSelectorAsSelector(&exposure.Spec.ServiceSelector)
+	if err != nil {
+		return false, err
+	}
+	return selector.Matches(labels.Set(svc.Labels)), nil
+}
+
+// exposureServices Services selected by NBExposure, fails on invalid selectors
+func exposureServices(ctx context.Context, c client.Client, exposure netbirdiov1.NBExposure) ([]corev1.Service, error) {
+	err := validateExposureSelectors(exposure)
+	if err != nil {
+		return nil, err
+	}
+
+	var namespaces corev1.NamespaceList
+	err = c.List(ctx, &namespaces)
+	if err != nil {
+		return nil, err
+	}
+	nsLabels := make(map[string]map[string]string)
+	for _, ns := range namespaces.Items {
+		nsLabels[ns.Name] = ns.Labels
+	}
+
+	var services corev1.ServiceList
+	err = c.List(ctx, &services)
+	if err != nil {
+		return nil, err
+	}
+
+	var matched []corev1.Service
+	for _, svc := range services.Items {
+		ok, err := exposureMatches(exposure, svc, nsLabels[svc.Namespace])
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			matched = append(matched, svc)
+		}
+	}
+	return matched, nil
+}
+
+// serviceExposures NBExposures selecting Service, ordered by precedence (highest priority, then name, first)
+func serviceExposures(ctx context.Context, c client.Client, svc corev1.Service, logger logr.Logger) ([]netbirdiov1.NBExposure, error) {
+	var exposures netbirdiov1.NBExposureList
+	err := c.List(ctx, &exposures)
+	if err != nil {
+		return nil, err
+	}
+	if len(exposures.Items) == 0 {
+		return nil, nil
+	}
+
+	var ns corev1.Namespace
+	err = c.Get(ctx, types.NamespacedName{Name: svc.Namespace}, &ns)
+	if err != nil {
+		return nil, err
+	}
+
+	var matched []netbirdiov1.NBExposure
+	for _, exposure := range exposures.Items {
+		ok, err := exposureMatches(exposure, svc, ns.Labels)
+		if err != nil {
+			// Invalid selectors match nothing, reported on the NBExposure status
+			logger.Error(errInvalidValue, "invalid NBExposure selector, skipping", "exposure", exposure.Name, "err", err)
+			continue
+		}
+		if ok {
+			matched = append(matched, exposure)
+		}
+	}
+
+	slices.SortFunc(matched, func(a, b netbirdiov1.NBExposure) int {
+		if a.Spec.Priority != b.Spec.Priority {
+			return int(b.Spec.Priority) - int(a.Spec.Priority)
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+	return matched, nil
+}
+
+// exposureAnnotations merges NBExposure settings into Service annotations.
+// Per setting, Service annotations take precedence over NBExposures, which apply in the given order.
+func exposureAnnotations(annotations map[string]string, exposures []netbirdiov1.NBExposure) map[string]string {
+	if len(exposures) == 0 {
+		return annotations
+	}
+
+	merged := map[string]string{ServiceExposeAnnotation: "true"}
+	// Apply lowest precedence first so higher precedence NBExposures overwrite it
+	for _, exposure := range slices.Backward(exposures) {
+		spec := exposure.Spec
+		if len(spec.Groups) > 0 {
+			merged[serviceGroupsAnnotation] = strings.Join(spec.Groups, ",")
+		}
+		if spec.NetworkRef != nil {
+			merged[serviceNetworkAnnotation] = spec.NetworkRef.Name
+			if spec.NetworkRef.Namespace != "" {
+				merged[serviceNetworkAnnotation] = fmt.Sprintf("%s/%s", spec.NetworkRef.Namespace, spec.NetworkRef.Name)
+			}
+		}
+		if len(spec.Policies) > 0 {
+			merged[servicePolicyAnnotation] = strings.Join(spec.Policies, ",")
+		}
+		if len(spec.PolicyPorts) > 0 {
+			ports := make([]string, 0, len(spec.PolicyPorts))
+			for _, p := range spec.PolicyPorts {
+				ports = append(ports, fmt.Sprint(p))
+			}
+			merged[servicePortsAnnotation] = strings.Join(ports, ",")
+		}
+		if spec.PolicyProtocol != "" {
+			merged[serviceProtocolAnnotation] = spec.PolicyProtocol
+		}
+		if len(spec.PolicySourceGroups) > 0 {
+			merged[servicePolicySourceGroupsAnnotation] = strings.Join(spec.PolicySourceGroups, ",")
+		}
+	}
+
+	for k, v := range annotations {
+		merged[k] = v
+	}
+	return merged
+}
+
+// exposedServices enqueues Services matched by NBExposure, called for both old and new objects on updates
+func (r *ServiceReconciler) exposedServices(ctx context.Context, obj client.Object) []reconcile.Request {
+	exposure, ok := obj.(*netbirdiov1.NBExposure)
+	if !ok {
+		return nil
+	}
+
+	services, err := exposureServices(ctx, r.Client, *exposure)
+	if err != nil {
+		// Previously matched Services are still enqueued below
+		ctrl.Log.WithName("Service").Error(errKubernetesAPI, "error listing Services matched by NBExposure", "err", err)
+	}
+
+	requests := make([]reconcile.Request, 0, len(services)+len(exposure.Status.MatchedServices))
+	for _, svc := range services {
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: svc.Namespace, Name: svc.Name}})
+	}
+	// Services no longer matched need to be hidden again
+	for _, matched := range exposure.Status.MatchedServices {
+		namespace, name, _ := strings.Cut(matched, "/")
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: namespace, Name: name}})
+	}
+	return requests
+}
+
+// namespaceServices enqueues Services of a Namespace whose labels may change NBExposure matches
+func (r *ServiceReconciler) namespaceServices(ctx context.Context, obj client.Object) []reconcile.Request {
+	var exposures netbirdiov1.NBExposureList
+	err := r.Client.List(ctx, &exposures)
+	if err != nil || !slices.ContainsFunc(exposures.Items, func(e netbirdiov1.NBExposure) bool { return e.Spec.NamespaceSelector != nil }) {
+		return nil
+	}
+
+	var services corev1.ServiceList
+	err = r.Client.List(ctx, &services, client.InNamespace(obj.GetName()))
+	if err != nil {
+		ctrl.Log.WithName("Service").Error(errKubernetesAPI, "error listing Services", "err", err)
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(services.Items))
+	for _, svc := range services.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: svc.Namespace, Name: svc.Name}})
+	}
+	return requests
+}
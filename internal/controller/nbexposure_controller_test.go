@@ -0,0 +1,182 @@
+package controller
+
+import (
+	netbirdiov1 "github.com/netbirdio/kubernetes-operator/api/v1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ = Describe("NBExposure Controller", func() {
+	Context("When reconciling a resource", func() {
+		typeNamespacedName := types.NamespacedName{
+			Name: "test-exposure",
+		}
+		serviceNamespacedName := types.NamespacedName{
+			Namespace: "default",
+			Name:      "exposure-test",
+		}
+
+		var controllerReconciler *NBExposureReconciler
+
+		BeforeEach(func() {
+			exposure := &netbirdiov1.NBExposure{
+				ObjectMeta: v1.ObjectMeta{
+					Name: typeNamespacedName.Name,
+				},
+				Spec: netbirdiov1.NBExposureSpec{
+					ServiceSelector: v1.LabelSelector{
+						MatchLabels: map[string]string{"tier": "internal"},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, exposure)).To(Succeed())
+
+			svc := &corev1.Service{
+				ObjectMeta: v1.ObjectMeta{
+					Name:      serviceNamespacedName.Name,
+					Namespace: serviceNamespacedName.Namespace,
+					Labels:    map[string]string{"tier": "internal"},
+				},
+				Spec: corev1.ServiceSpec{
+					Ports: []corev1.ServicePort{{Port: 80}},
+				},
+			}
+			Expect(k8sClient.Create(ctx, svc)).To(Succeed())
+
+			controllerReconciler = &NBExposureReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+		})
+
+		AfterEach(func() {
+			exposure := &netbirdiov1.NBExposure{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, exposure)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, exposure)).To(Succeed())
+
+			svc := &corev1.Service{}
+			Expect(k8sClient.Get(ctx, serviceNamespacedName, svc)).To(Succeed())
+			err := k8sClient.Delete(ctx, svc)
+			if !errors.IsNotFound(err) {
+				Expect(err).NotTo(HaveOccurred())
+			}
+		})
+
+		It("should list matched Services", func() {
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespacedName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			exposure := &netbirdiov1.NBExposure{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, exposure)).To(Succeed())
+			Expect(exposure.Status.MatchedServices).To(Equal([]string{"default/exposure-test"}))
+		})
+
+		It("should not match Services outside the namespace selector", func() {
+			exposure := &netbirdiov1.NBExposure{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, exposure)).To(Succeed())
+			exposure.Spec.NamespaceSelector = &v1.LabelSelector{
+				MatchLabels: map[string]string{"team": "x"},
+			}
+			Expect(k8sClient.Update(ctx, exposure)).To(Succeed())
+
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespacedName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, typeNamespacedName, exposure)).To(Succeed())
+			Expect(exposure.Status.MatchedServices).To(BeEmpty())
+		})
+
+		It("should report invalid selectors without clearing matched Services", func() {
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespacedName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			exposure := &netbirdiov1.NBExposure{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, exposure)).To(Succeed())
+			exposure.Spec.ServiceSelector.MatchExpressions = []v1.LabelSelectorRequirement{
+				{Key: "tier", Operator: "Bogus"},
+			}
+			Expect(k8sClient.Update(ctx, exposure)).To(Succeed())
+
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespacedName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, typeNamespacedName, exposure)).To(Succeed())
+			Expect(exposure.Status.MatchedServices).To(Equal([]string{"default/exposure-test"}))
+			Expect(exposure.Status.Conditions).To(HaveLen(1))
+			Expect(exposure.Status.Conditions[0].Status).To(Equal(corev1.ConditionFalse))
+			Expect(exposure.Status.Conditions[0].Reason).To(Equal("InvalidSelector"))
+		})
+
+		It("should only enqueue NBExposures selecting a changed Service", func() {
+			svc := &corev1.Service{}
+			Expect(k8sClient.Get(ctx, serviceNamespacedName, svc)).To(Succeed())
+			Expect(controllerReconciler.serviceExposureRequests(ctx, svc)).To(ConsistOf(
+				reconcile.Request{NamespacedName: typeNamespacedName},
+			))
+
+			svc.Labels = map[string]string{"tier": "public"}
+			Expect(controllerReconciler.serviceExposureRequests(ctx, svc)).To(BeEmpty())
+
+			By("still enqueueing NBExposures that matched the Service before")
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespacedName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(controllerReconciler.serviceExposureRequests(ctx, svc)).To(ConsistOf(
+				reconcile.Request{NamespacedName: typeNamespacedName},
+			))
+		})
+	})
+
+	Context("When merging NBExposures with annotations", func() {
+		exposures := []netbirdiov1.NBExposure{
+			{
+				ObjectMeta: v1.ObjectMeta{Name: "high"},
+				Spec: netbirdiov1.NBExposureSpec{
+					Priority: 10,
+					Groups:   []string{"high"},
+				},
+			},
+			{
+				ObjectMeta: v1.ObjectMeta{Name: "low"},
+				Spec: netbirdiov1.NBExposureSpec{
+					Groups:         []string{"low"},
+					NetworkRef:     &netbirdiov1.NBResourceNetworkRef{Namespace: "netbird", Name: "router"},
+					Policies:       []string{"default"},
+					PolicyPorts:    []int32{80, 443},
+					PolicyProtocol: "tcp",
+				},
+			},
+		}
+
+		It("should apply settings by precedence", func() {
+			Expect(exposureAnnotations(map[string]string{
+				servicePolicyAnnotation: "custom",
+			}, exposures)).To(Equal(map[string]string{
+				ServiceExposeAnnotation:   "true",
+				serviceGroupsAnnotation:   "high",
+				serviceNetworkAnnotation:  "netbird/router",
+				servicePolicyAnnotation:   "custom",
+				servicePortsAnnotation:    "80,443",
+				serviceProtocolAnnotation: "tcp",
+			}))
+		})
+
+		It("should leave annotations of unmatched Services untouched", func() {
+			Expect(exposureAnnotations(nil, nil)).To(BeNil())
+		})
+	})
+})
@@ -80,6 +80,11 @@ func (r *NBResourceReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{}, r.handleDelete(ctx, req, nbResource, logger)
 	}
 
+	result, err := r.handleNetwork(ctx, nbResource, logger)
+	if result != nil {
+		return *result, err
+	}
+
 	groupIDs, result, err := r.handleGroups(ctx, req, nbResource, logger)
 	if result != nil {
 		nbResource.Status.Conditions = netbirdiov1.NBConditionFalse("internalError", fmt.Sprintf("Error occurred handling groups: %v", err))
@@ -351,6 +356,48 @@ func (r *NBResourceReconciler) handlePolicy(ctx context.Context, req ctrl.Reques
 	return nil
 }
 
+// handleNetwork resolves the NetBird Network of the resource, moving the resource when spec.networkRef
+// references another network
+func (r *NBResourceReconciler) handleNetwork(ctx context.Context, nbResource *netbirdiov1.NBResource, logger logr.Logger) (*ctrl.Result, error) {
+	networkID := nbResource.Spec.NetworkID
+	if nbResource.Spec.NetworkRef != nil {
+		ref := types.NamespacedName{Namespace: nbResource.Spec.NetworkRef.Namespace, Name: nbResource.Spec.NetworkRef.Name}
+		if ref.Namespace == "" {
+			ref.Namespace = nbResource.Namespace
+		}
+
+		var routingPeer netbirdiov1.NBRoutingPeer
+		err := r.Client.Get(ctx, ref, &routingPeer)
+		if err != nil && !errors.IsNotFound(err) {
+			logger.Error(errKubernetesAPI, "error getting NBRoutingPeer", "err", err)
+			nbResource.Status.Conditions = netbirdiov1.NBConditionFalse("internalError", fmt.Sprintf("error getting NBRoutingPeer: %v", err))
+			return &ctrl.Result{}, err
+		}
+
+		if errors.IsNotFound(err) || routingPeer.Status.NetworkID == nil {
+			// Reconciled again through NBRoutingPeer watch once network is available
+			logger.Info("Network not available", "routingPeer", ref.String())
+			nbResource.Status.Conditions = netbirdiov1.NBConditionFalse("NetworkNotReady", fmt.Sprintf("network of NBRoutingPeer %s is not available", ref.String()))
+			return &ctrl.Result{}, nil
+		}
+		networkID = *routingPeer.Status.NetworkID
+	}
+
+	if nbResource.Status.NetworkID != nil && *nbResource.Status.NetworkID != networkID && nbResource.Status.NetworkResourceID != nil {
+		logger.Info("Moving network resource to new network", "from", *nbResource.Status.NetworkID, "to", networkID)
+		err := r.netbird.Networks.Resources(*nbResource.Status.NetworkID).Delete(ctx, *nbResource.Status.NetworkResourceID)
+		if err != nil && !strings.Contains(err.Error(), "not found") {
+			logger.Error(errNetBirdAPI, "error deleting resource", "err", err)
+			nbResource.Status.Conditions = netbirdiov1.NBConditionFalse("APIError", fmt.Sprintf("error deleting resource: %v", err))
+			return &ctrl.Result{}, err
+		}
+		nbResource.Status.NetworkResourceID = nil
+	}
+
+	nbResource.Status.NetworkID = &networkID
+	return nil, nil
+}
+
 // handleGroupUpdate update network resource groups
 func (r *NBResourceReconciler) handleGroupUpdate(ctx context.Context, nbResource *netbirdiov1.NBResource, groupIDs []string, resource *api.NetworkResource, logger logr.Logger) error {
 	// Handle possible updated group IDs
@@ -367,11 +414,11 @@ func (r *NBResourceReconciler) handleGroupUpdate(ctx context.Context, nbResource
 	}
 
 	if diffFound {
-		_, err := r.netbird.Networks.Resources(nbResource.Spec.NetworkID).Update(ctx, resource.Id, api.NetworkResourceRequest{
+		_, err := r.netbird.Networks.Resources(nbResource.ResolvedNetworkID()).Update(ctx, resource.Id, api.NetworkResourceRequest{
 			Name:        nbResource.Spec.Name,
-			Description: &networkDescription,
+			Description: util.Ptr(r.resourceDescription(nbResource)),
 			Address:     nbResource.Spec.Address,
-			Enabled:     true,
+			Enabled:     nbResource.Spec.IsEnabled(),
 			Groups:      groupIDs,
 		})
 
@@ -384,12 +431,22 @@ func (r *NBResourceReconciler) handleGroupUpdate(ctx context.Context, nbResource
 	return nil
 }
 
+// resourceDescription description of network resources, tags resources with the cluster they belong to
+// as networks may be shared between clusters
+func (r *NBResourceReconciler) resourceDescription(nbResource *netbirdiov1.NBResource) string {
+	description := networkDescription
+	if nbResource.Spec.Description != "" {
+		description = nbResource.Spec.Description
+	}
+	return fmt.Sprintf("%s (cluster: %s)", description, r.ClusterName)
+}
+
 // handleNetBirdResource sync NetBird Network Resource
 func (r *NBResourceReconciler) handleNetBirdResource(ctx context.Context, nbResource *netbirdiov1.NBResource, groupIDs []string, logger logr.Logger) (*api.NetworkResource, error) {
 	var resource *api.NetworkResource
 	var err error
 	if nbResource.Status.NetworkResourceID != nil {
-		resource, err = r.netbird.Networks.Resources(nbResource.Spec.NetworkID).Get(ctx, *nbResource.Status.NetworkResourceID)
+		resource, err = r.netbird.Networks.Resources(nbResource.ResolvedNetworkID()).Get(ctx, *nbResource.Status.NetworkResourceID)
 		if err != nil && !strings.Contains(err.Error(), "not found") {
 			logger.Error(errNetBirdAPI, "error getting network resource", "err", err)
 			return nil, err
@@ -398,11 +455,11 @@ func (r *NBResourceReconciler) handleNetBirdResource(ctx context.Context, nbReso
 
 	// Create/Update upstream network resource
 	if nbResource.Status.NetworkResourceID == nil && resource == nil {
-		resource, err := r.netbird.Networks.Resources(nbResource.Spec.NetworkID).Create(ctx, api.NetworkResourceRequest{
+		resource, err := r.netbird.Networks.Resources(nbResource.ResolvedNetworkID()).Create(ctx, api.NetworkResourceRequest{
 			Address:     nbResource.Spec.Address,
-			Enabled:     true,
+			Enabled:     nbResource.Spec.IsEnabled(),
 			Groups:      groupIDs,
-			Description: &networkDescription,
+			Description: util.Ptr(r.resourceDescription(nbResource)),
 			Name:        nbResource.Spec.Name,
 		})
 
@@ -422,15 +479,16 @@ func (r *NBResourceReconciler) handleNetBirdResource(ctx context.Context, nbReso
 			resourceGroups = append(resourceGroups, v.Id)
 		}
 		if resource.Address != nbResource.Spec.Address ||
-			!resource.Enabled ||
+			resource.Enabled != nbResource.Spec.IsEnabled() ||
 			!util.Equivalent(resourceGroups, groupIDs) ||
-			*resource.Description != networkDescription ||
+			resource.Description == nil ||
+			*resource.Description != r.resourceDescription(nbResource) ||
 			resource.Name != nbResource.Spec.Name {
-			_, err = r.netbird.Networks.Resources(nbResource.Spec.NetworkID).Update(ctx, *nbResource.Status.NetworkResourceID, api.NetworkResourceRequest{
+			_, err = r.netbird.Networks.Resources(nbResource.ResolvedNetworkID()).Update(ctx, *nbResource.Status.NetworkResourceID, api.NetworkResourceRequest{
 				Address:     nbResource.Spec.Address,
-				Enabled:     true,
+				Enabled:     nbResource.Spec.IsEnabled(),
 				Groups:      groupIDs,
-				Description: &networkDescription,
+				Description: util.Ptr(r.resourceDescription(nbResource)),
 				Name:        nbResource.Spec.Name,
 			})
 			if err != nil {
@@ -583,7 +641,7 @@ func (r *NBResourceReconciler) handleDelete(ctx context.Context, req ctrl.Reques
 	}
 
 	if nbResource.Status.NetworkResourceID != nil {
-		err := r.netbird.Networks.Resources(nbResource.Spec.NetworkID).Delete(ctx, *nbResource.Status.NetworkResourceID)
+		err := r.netbird.Networks.Resources(nbResource.ResolvedNetworkID()).Delete(ctx, *nbResource.Status.NetworkResourceID)
 		if err != nil && !strings.Contains(err.Error(), "not found") {
 			logger.Error(errNetBirdAPI, "error deleting resource", "err", err)
 			return err
@@ -645,6 +703,7 @@ func (r *NBResourceReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		For(&netbirdiov1.NBResource{}).
 		Named("nbresource").
 		Watches(&netbirdiov1.NBGroup{}, handler.EnqueueRequestForOwner(r.Scheme, mgr.GetRESTMapper(), &netbirdiov1.NBResource{})).
+		Watches(&netbirdiov1.NBRoutingPeer{}, handler.EnqueueRequestsFromMapFunc(r.routingPeerResources)).
 		Watches(&netbirdiov1.NBPolicy{}, handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
 			if v, ok := obj.GetAnnotations()["netbird.io/generated-by"]; ok {
 				return []reconcile.Request{
@@ -660,3 +719,27 @@ func (r *NBResourceReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		})).
 		Complete(r)
 }
+
+// routingPeerResources maps NBRoutingPeer events to NBResources referencing its network
+func (r *NBResourceReconciler) routingPeerResources(ctx context.Context, obj client.Object) []reconcile.Request {
+	nbResourceList := netbirdiov1.NBResourceList{}
+	err := r.Client.List(ctx, &nbResourceList)
+	if err != nil {
+		ctrl.Log.WithName("NBResource").Error(errKubernetesAPI, "error listing NBResource", "err", err)
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, nbResource := range nbResourceList.Items {
+		ref := nbResource.Spec.NetworkRef
+		if ref == nil || ref.Name != obj.GetName() {
+			continue
+		}
+		if (ref.Namespace == "" && nbResource.Namespace != obj.GetNamespace()) || (ref.Namespace != "" && ref.Namespace != obj.GetNamespace()) {
+			continue
+		}
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: nbResource.Namespace, Name: nbResource.Name}})
+	}
+
+	return requests
+}
@@ -106,6 +106,82 @@ var _ = Describe("NBResource Controller", func() {
 			})
 		})
 
+		When("Network is referenced by NBRoutingPeer", func() {
+			BeforeEach(func() {
+				Expect(k8sClient.Get(ctx, typeNamespacedName, nbresource)).To(Succeed())
+				nbresource.Spec.NetworkID = ""
+				nbresource.Spec.NetworkRef = &netbirdiov1.NBResourceNetworkRef{Name: "resource-router"}
+				Expect(k8sClient.Update(ctx, nbresource)).To(Succeed())
+			})
+
+			AfterEach(func() {
+				nbrp := &netbirdiov1.NBRoutingPeer{}
+				err := k8sClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "resource-router"}, nbrp)
+				if !errors.IsNotFound(err) {
+					Expect(err).NotTo(HaveOccurred())
+					Expect(k8sClient.Delete(ctx, nbrp)).To(Succeed())
+				}
+
+				nbGroup := &netbirdiov1.NBGroup{}
+				err = k8sClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "meow"}, nbGroup)
+				if !errors.IsNotFound(err) {
+					Expect(err).NotTo(HaveOccurred())
+					if len(nbGroup.Finalizers) > 0 {
+						nbGroup.Finalizers = nil
+						Expect(k8sClient.Update(ctx, nbGroup)).To(Succeed())
+					}
+					Expect(k8sClient.Delete(ctx, nbGroup)).To(Succeed())
+				}
+			})
+
+			It("should wait for NBRoutingPeer network", func() {
+				_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+					NamespacedName: typeNamespacedName,
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(k8sClient.Get(ctx, typeNamespacedName, nbresource)).To(Succeed())
+				Expect(nbresource.Status.NetworkID).To(BeNil())
+				Expect(nbresource.Status.Conditions).To(HaveLen(1))
+				Expect(nbresource.Status.Conditions[0].Reason).To(Equal("NetworkNotReady"))
+			})
+
+			It("should move network resource to the referenced network", func() {
+				nbrp := &netbirdiov1.NBRoutingPeer{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "default",
+						Name:      "resource-router",
+					},
+				}
+				Expect(k8sClient.Create(ctx, nbrp)).To(Succeed())
+				nbrp.Status.NetworkID = util.Ptr("test")
+				Expect(k8sClient.Status().Update(ctx, nbrp)).To(Succeed())
+
+				nbresource.Status.NetworkID = util.Ptr("old")
+				nbresource.Status.NetworkResourceID = util.Ptr("old-resource")
+				Expect(k8sClient.Status().Update(ctx, nbresource)).To(Succeed())
+
+				resourceDeleted := false
+				mux.HandleFunc("/api/networks/old/resources/old-resource", func(w http.ResponseWriter, r *http.Request) {
+					defer GinkgoRecover()
+					Expect(r.Method).To(Equal(http.MethodDelete))
+					resourceDeleted = true
+					_, err := w.Write([]byte(`{}`))
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+					NamespacedName: typeNamespacedName,
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resourceDeleted).To(BeTrue())
+
+				Expect(k8sClient.Get(ctx, typeNamespacedName, nbresource)).To(Succeed())
+				Expect(nbresource.Status.NetworkID).To(BeEquivalentTo(util.Ptr("test")))
+				Expect(nbresource.Status.NetworkResourceID).To(BeNil())
+			})
+		})
+
 		When("Network Resource doesn't exist", Ordered, func() {
 			AfterAll(func() {
 				nbGroup := &netbirdiov1.NBGroup{}
@@ -145,7 +221,7 @@ var _ = Describe("NBResource Controller", func() {
 
 						Expect(req.Name).To(Equal("Test"))
 						Expect(req.Description).NotTo(BeNil())
-						Expect(*req.Description).To(BeEquivalentTo("Created by kubernetes-operator"))
+						Expect(*req.Description).To(BeEquivalentTo("Created by kubernetes-operator (cluster: kubernetes)"))
 						Expect(req.Enabled).To(BeTrue())
 						Expect(req.Groups).To(ConsistOf([]string{"test"}))
 						Expect(req.Address).To(Equal(nbresource.Spec.Address))
@@ -254,7 +330,7 @@ var _ = Describe("NBResource Controller", func() {
 
 							Expect(req.Name).To(Equal("Test"))
 							Expect(req.Description).NotTo(BeNil())
-							Expect(*req.Description).To(BeEquivalentTo("Created by kubernetes-operator"))
+							Expect(*req.Description).To(BeEquivalentTo("Created by kubernetes-operator (cluster: kubernetes)"))
 							Expect(req.Enabled).To(BeTrue())
 							Expect(req.Groups).To(ConsistOf([]string{"test"}))
 							Expect(req.Address).To(Equal(nbresource.Spec.Address))
@@ -291,6 +367,53 @@ var _ = Describe("NBResource Controller", func() {
 				})
 			})
 
+			When("Network Resource is disabled", func() {
+				It("should disable Network Resource", func() {
+					resourceUpdated := false
+					mux.HandleFunc("/api/networks/test/resources/test", func(w http.ResponseWriter, r *http.Request) {
+						defer GinkgoRecover()
+						resp := api.NetworkResource{
+							Address:     nbresource.Spec.Address,
+							Description: util.Ptr(controllerReconciler.resourceDescription(nbresource)),
+							Enabled:     true,
+							Groups: []api.GroupMinimum{
+								{
+									Id:   "test",
+									Name: "meow",
+								},
+							},
+							Id:   "test",
+							Name: nbresource.Spec.Name,
+							Type: api.NetworkResourceTypeDomain,
+						}
+						if r.Method == http.MethodPut {
+							resourceUpdated = true
+							bs, err := io.ReadAll(r.Body)
+							Expect(err).NotTo(HaveOccurred())
+							var req api.PutApiNetworksNetworkIdResourcesResourceIdJSONRequestBody
+							Expect(json.Unmarshal(bs, &req)).To(Succeed())
+							Expect(req.Enabled).To(BeFalse())
+							resp.Enabled = req.Enabled
+						}
+						bs, err := json.Marshal(resp)
+						Expect(err).NotTo(HaveOccurred())
+						_, err = w.Write(bs)
+						Expect(err).NotTo(HaveOccurred())
+					})
+
+					nbresource.Spec.Enabled = util.Ptr(false)
+					Expect(k8sClient.Update(ctx, nbresource)).To(Succeed())
+					nbresource.Status.NetworkResourceID = util.Ptr("test")
+					Expect(k8sClient.Status().Update(ctx, nbresource)).To(Succeed())
+
+					_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+						NamespacedName: typeNamespacedName,
+					})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(resourceUpdated).To(BeTrue())
+				})
+			})
+
 			When("Network Resource is up-to-date", func() {
 				BeforeEach(func() {
 					mux.HandleFunc("/api/networks/test/resources/test", func(w http.ResponseWriter, r *http.Request) {
@@ -298,7 +421,7 @@ var _ = Describe("NBResource Controller", func() {
 						if r.Method == http.MethodGet {
 							resp := api.NetworkResource{
 								Address:     nbresource.Spec.Address,
-								Description: &networkDescription,
+								Description: util.Ptr(controllerReconciler.resourceDescription(nbresource)),
 								Enabled:     true,
 								Groups: []api.GroupMinimum{
 									{
@@ -0,0 +1,435 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+
+	"github.com/go-logr/logr"
+	netbirdiov1 "github.com/netbirdio/kubernetes-operator/api/v1"
+	"github.com/netbirdio/kubernetes-operator/internal/util"
+	netbird "github.com/netbirdio/netbird/management/client/rest"
+	"github.com/netbirdio/netbird/management/server/http/api"
+)
+
+// NBRouteReconciler reconciles a NBRoute object
+type NBRouteReconciler struct {
+	client.Client
+	Scheme        *runtime.Scheme
+	APIKey        string
+	ManagementURL string
+	DefaultLabels map[string]string
+	netbird       *netbird.Client
+}
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (r *NBRouteReconciler) Reconcile(ctx context.Context, req ctrl.Request) (res ctrl.Result, err error) {
+	logger := ctrl.Log.WithName("NBRoute").WithValues("namespace", req.Namespace, "name", req.Name)
+	logger.Info("Reconciling NBRoute")
+
+	nbRoute := &netbirdiov1.NBRoute{}
+	err = r.Client.Get(ctx, req.NamespacedName, nbRoute)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			logger.Error(errKubernetesAPI, "error getting NBRoute", "err", err)
+		}
+		return ctrl.Result{RequeueAfter: defaultRequeueAfter}, nil
+	}
+
+	originalRoute := nbRoute.DeepCopy()
+
+	defer func() {
+		if err != nil {
+			// double check result is nil, otherwise error is not printed
+			// and exponential backoff doesn't work properly
+			res = ctrl.Result{}
+			return
+		}
+		if originalRoute.DeletionTimestamp != nil && len(nbRoute.Finalizers) == 0 {
+			return
+		}
+		if !originalRoute.Status.Equal(nbRoute.Status) {
+			updateErr := r.Client.Status().Update(ctx, nbRoute)
+			if updateErr != nil {
+				err = updateErr
+			}
+		}
+		if !res.Requeue && res.RequeueAfter == 0 {
+			res.RequeueAfter = defaultRequeueAfter
+		}
+	}()
+
+	if nbRoute.DeletionTimestamp != nil {
+		if len(nbRoute.Finalizers) == 0 {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, r.handleDelete(ctx, req, nbRoute, logger)
+	}
+
+	if !util.Contains(nbRoute.Finalizers, "netbird.io/cleanup") {
+		nbRoute.Finalizers = append(nbRoute.Finalizers, "netbird.io/cleanup")
+		err = r.Client.Update(ctx, nbRoute)
+		if err != nil {
+			logger.Error(errKubernetesAPI, "error updating NBRoute", "err", err)
+			return ctrl.Result{}, err
+		}
+	}
+
+	groupIDs, result, err := r.handleGroups(ctx, req, nbRoute, logger)
+	if result != nil {
+		if err != nil {
+			nbRoute.Status.Conditions = netbirdiov1.NBConditionFalse("internalError", fmt.Sprintf("Error occurred handling groups: %v", err))
+		}
+		return *result, err
+	}
+
+	requeue, err := r.handleRoute(ctx, nbRoute, groupIDs, logger)
+	if err != nil {
+		nbRoute.Status.Conditions = netbirdiov1.NBConditionFalse("APIError", fmt.Sprintf("Error occurred handling NetBird route: %v", err))
+		return ctrl.Result{}, err
+	}
+	if requeue {
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	nbRoute.Status.Conditions = netbirdiov1.NBConditionTrue()
+
+	return ctrl.Result{}, nil
+}
+
+// routeRequest builds NetBird route request from NBRoute spec
+func routeRequest(nbRoute *netbirdiov1.NBRoute, groupIDs map[string]string) api.RouteRequest {
+	namesToIDs := func(names []string) []string {
+		ids := make([]string, 0, len(names))
+		for _, n := range names {
+			ids = append(ids, groupIDs[n])
+		}
+		return ids
+	}
+
+	request := api.RouteRequest{
+		Description: nbRoute.Spec.Description,
+		Enabled:     nbRoute.Spec.Enabled == nil || *nbRoute.Spec.Enabled,
+		Groups:      namesToIDs(nbRoute.Spec.Groups),
+		KeepRoute:   nbRoute.Spec.KeepRoute,
+		Masquerade:  nbRoute.Spec.Masquerade == nil || *nbRoute.Spec.Masquerade,
+		Metric:      nbRoute.Spec.Metric,
+		NetworkId:   nbRoute.Spec.NetworkIdentifier,
+	}
+	if request.Metric == 0 {
+		request.Metric = 9999
+	}
+	if nbRoute.Spec.Network != "" {
+		request.Network = &nbRoute.Spec.Network
+	}
+	if len(nbRoute.Spec.Domains) > 0 {
+		request.Domains = &nbRoute.Spec.Domains
+	}
+	if nbRoute.Spec.Peer != "" {
+		request.Peer = &nbRoute.Spec.Peer
+	}
+	if len(nbRoute.Spec.PeerGroups) > 0 {
+		request.PeerGroups = util.Ptr(namesToIDs(nbRoute.Spec.PeerGroups))
+	}
+	if len(nbRoute.Spec.AccessControlGroups) > 0 {
+		request.AccessControlGroups = util.Ptr(namesToIDs(nbRoute.Spec.AccessControlGroups))
+	}
+
+	return request
+}
+
+// routeUpToDate checks if NetBird route matches route request
+func routeUpToDate(route *api.Route, request api.RouteRequest) bool {
+	optionalEquivalent := func(a, b *[]string) bool {
+		if a == nil || b == nil {
+			return (a == nil || len(*a) == 0) && (b == nil || len(*b) == 0)
+		}
+		return util.Equivalent(*a, *b)
+	}
+	optionalEqual := func(a, b *string) bool {
+		if a == nil || b == nil {
+			return (a == nil || *a == "") && (b == nil || *b == "")
+		}
+		return *a == *b
+	}
+
+	return route.Description == request.Description &&
+		route.Enabled == request.Enabled &&
+		util.Equivalent(route.Groups, request.Groups) &&
+		route.KeepRoute == request.KeepRoute &&
+		route.Masquerade == request.Masquerade &&
+		route.Metric == request.Metric &&
+		route.NetworkId == request.NetworkId &&
+		optionalEqual(route.Network, request.Network) &&
+		optionalEquivalent(route.Domains, request.Domains) &&
+		optionalEqual(route.Peer, request.Peer) &&
+		optionalEquivalent(route.PeerGroups, request.PeerGroups) &&
+		optionalEquivalent(route.AccessControlGroups, request.AccessControlGroups)
+}
+
+// handleRoute create/update NetBird route
+func (r *NBRouteReconciler) handleRoute(ctx context.Context, nbRoute *netbirdiov1.NBRoute, groupIDs map[string]string, logger logr.Logger) (bool, error) {
+	request := routeRequest(nbRoute, groupIDs)
+
+	if nbRoute.Status.RouteID == nil {
+		logger.Info("Creating NetBird route", "network-id", request.NetworkId)
+		route, err := r.netbird.Routes.Create(ctx, request)
+		if err != nil {
+			logger.Error(errNetBirdAPI, "error creating route", "err", err)
+			return false, err
+		}
+
+		nbRoute.Status.RouteID = &route.Id
+		return false, nil
+	}
+
+	route, err := r.netbird.Routes.Get(ctx, *nbRoute.Status.RouteID)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			// Route was deleted elsewhere, recreate
+			nbRoute.Status.RouteID = nil
+			return true, nil
+		}
+		logger.Error(errNetBirdAPI, "error getting route", "err", err)
+		return false, err
+	}
+
+	if !routeUpToDate(route, request) {
+		logger.Info("Updating NetBird route", "id", route.Id)
+		_, err = r.netbird.Routes.Update(ctx, route.Id, request)
+		if err != nil {
+			logger.Error(errNetBirdAPI, "error updating route", "err", err)
+			return false, err
+		}
+	}
+
+	return false, nil
+}
+
+// routeGroupNames all group names referenced by NBRoute
+func routeGroupNames(nbRoute *netbirdiov1.NBRoute) []string {
+	var groupNames []string
+	for _, g := range slices.Concat(nbRoute.Spec.PeerGroups, nbRoute.Spec.Groups, nbRoute.Spec.AccessControlGroups) {
+		if !util.Contains(groupNames, g) {
+			groupNames = append(groupNames, g)
+		}
+	}
+	return groupNames
+}
+
+// handleGroups create NBGroup objects for each group referenced in NBRoute, returns group name to ID mapping
+func (r *NBRouteReconciler) handleGroups(ctx context.Context, req ctrl.Request, nbRoute *netbirdiov1.NBRoute, logger logr.Logger) (map[string]string, *ctrl.Result, error) {
+	groupNames := routeGroupNames(nbRoute)
+
+	nbGroupList := netbirdiov1.NBGroupList{}
+	err := r.Client.List(ctx, &nbGroupList, &client.ListOptions{Namespace: req.Namespace})
+	if err != nil {
+		logger.Error(errKubernetesAPI, "error listing NBGroup", "err", err)
+		return nil, &ctrl.Result{}, err
+	}
+
+	// Release groups no longer referenced
+	for _, g := range nbGroupList.Items {
+		if util.Contains(groupNames, g.Spec.Name) {
+			continue
+		}
+		err = r.releaseGroup(ctx, nbRoute, g, logger)
+		if err != nil {
+			return nil, &ctrl.Result{}, err
+		}
+	}
+
+	groupIDs := make(map[string]string)
+	for _, groupName := range groupNames {
+		nbGroup := netbirdiov1.NBGroup{}
+		groupNameRFC := strings.ToLower(groupName)
+		groupNameRFC = strings.ReplaceAll(groupNameRFC, " ", "-")
+
+		// Prefer NBGroups already managing this group, for example the routing peer group
+		groupFound := false
+		for _, g := range nbGroupList.Items {
+			if g.Spec.Name == groupName {
+				nbGroup = g
+				groupFound = true
+				break
+			}
+		}
+
+		var err error
+		if !groupFound {
+			err = r.Client.Get(ctx, types.NamespacedName{Namespace: req.Namespace, Name: groupNameRFC}, &nbGroup)
+			if err != nil && !errors.IsNotFound(err) {
+				logger.Error(errKubernetesAPI, "error getting NBGroup", "err", err)
+				return nil, &ctrl.Result{}, err
+			}
+		}
+
+		if errors.IsNotFound(err) {
+			nbGroup = netbirdiov1.NBGroup{
+				ObjectMeta: v1.ObjectMeta{
+					Name:      groupNameRFC,
+					Namespace: nbRoute.Namespace,
+					OwnerReferences: []v1.OwnerReference{
+						{
+							APIVersion:         netbirdiov1.GroupVersion.Identifier(),
+							Kind:               "NBRoute",
+							Name:               nbRoute.Name,
+							UID:                nbRoute.UID,
+							BlockOwnerDeletion: util.Ptr(true),
+						},
+					},
+					Finalizers: []string{"netbird.io/group-cleanup", "netbird.io/route-cleanup"},
+					Labels:     r.DefaultLabels,
+				},
+				Spec: netbirdiov1.NBGroupSpec{
+					Name: groupName,
+				},
+			}
+
+			err = r.Client.Create(ctx, &nbGroup)
+			if err != nil {
+				logger.Error(errKubernetesAPI, "error creating NBGroup", "err", err)
+				return nil, &ctrl.Result{}, err
+			}
+
+			continue
+		}
+
+		// Add NBRoute as owner to NBGroup if not already done
+		ownerExists := false
+		for _, o := range nbGroup.OwnerReferences {
+			if o.UID == nbRoute.UID {
+				ownerExists = true
+			}
+		}
+
+		if !ownerExists || !util.Contains(nbGroup.Finalizers, "netbird.io/route-cleanup") {
+			if !ownerExists {
+				nbGroup.OwnerReferences = append(nbGroup.OwnerReferences, v1.OwnerReference{
+					APIVersion:         netbirdiov1.GroupVersion.Identifier(),
+					Kind:               "NBRoute",
+					Name:               nbRoute.Name,
+					UID:                nbRoute.UID,
+					BlockOwnerDeletion: util.Ptr(true),
+				})
+			}
+			if !util.Contains(nbGroup.Finalizers, "netbird.io/route-cleanup") {
+				nbGroup.Finalizers = append(nbGroup.Finalizers, "netbird.io/route-cleanup")
+			}
+
+			err = r.Client.Update(ctx, &nbGroup)
+			if err != nil {
+				logger.Error(errKubernetesAPI, "error updating NBGroup", "err", err)
+				return nil, &ctrl.Result{}, err
+			}
+		}
+
+		if nbGroup.Status.GroupID != nil {
+			groupIDs[groupName] = *nbGroup.Status.GroupID
+		}
+	}
+
+	// if not all groups are ready, requeue
+	if len(groupIDs) != len(groupNames) {
+		return nil, &ctrl.Result{RequeueAfter: 5 * time.Second}, nil
+	}
+
+	return groupIDs, nil, nil
+}
+
+// releaseGroup removes NBRoute ownership from NBGroup, and route cleanup finalizer if no other NBRoute owns the group
+func (r *NBRouteReconciler) releaseGroup(ctx context.Context, nbRoute *netbirdiov1.NBRoute, nbGroup netbirdiov1.NBGroup, logger logr.Logger) error {
+	ownerIndex := -1
+	otherRouteOwners := false
+	for idx, o := range nbGroup.OwnerReferences {
+		if o.UID == nbRoute.UID {
+			ownerIndex = idx
+		} else if o.Kind == "NBRoute" {
+			otherRouteOwners = true
+		}
+	}
+	if ownerIndex == -1 {
+		return nil
+	}
+
+	updated := false
+	if len(nbGroup.OwnerReferences) > 1 {
+		nbGroup.OwnerReferences = slices.Delete(nbGroup.OwnerReferences, ownerIndex, ownerIndex+1)
+		updated = true
+	}
+	if !otherRouteOwners && util.Contains(nbGroup.Finalizers, "netbird.io/route-cleanup") {
+		nbGroup.Finalizers = util.Without(nbGroup.Finalizers, "netbird.io/route-cleanup")
+		updated = true
+	}
+	if !updated {
+		return nil
+	}
+
+	err := r.Client.Update(ctx, &nbGroup)
+	if err != nil && !errors.IsNotFound(err) {
+		logger.Error(errKubernetesAPI, "error updating NBGroup", "err", err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *NBRouteReconciler) handleDelete(ctx context.Context, req ctrl.Request, nbRoute *netbirdiov1.NBRoute, logger logr.Logger) error {
+	if nbRoute.Status.RouteID != nil {
+		logger.Info("Deleting NetBird route", "id", *nbRoute.Status.RouteID)
+		err := r.netbird.Routes.Delete(ctx, *nbRoute.Status.RouteID)
+		if err != nil && !strings.Contains(err.Error(), "not found") {
+			logger.Error(errNetBirdAPI, "error deleting route", "err", err)
+			return err
+		}
+
+		nbRoute.Status.RouteID = nil
+	}
+
+	nbGroupList := netbirdiov1.NBGroupList{}
+	err := r.Client.List(ctx, &nbGroupList, &client.ListOptions{Namespace: req.Namespace})
+	if err != nil {
+		logger.Error(errKubernetesAPI, "error listing NBGroup", "err", err)
+		return err
+	}
+
+	for _, g := range nbGroupList.Items {
+		err = r.releaseGroup(ctx, nbRoute, g, logger)
+		if err != nil {
+			return err
+		}
+	}
+
+	if util.Contains(nbRoute.Finalizers, "netbird.io/cleanup") {
+		nbRoute.Finalizers = util.Without(nbRoute.Finalizers, "netbird.io/cleanup")
+		err = r.Client.Update(ctx, nbRoute)
+		if err != nil {
+			logger.Error(errKubernetesAPI, "error updating NBRoute", "err", err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *NBRouteReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.netbird = netbird.New(r.ManagementURL, r.APIKey)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&netbirdiov1.NBRoute{}).
+		Named("nbroute").
+		Watches(&netbirdiov1.NBGroup{}, handler.EnqueueRequestForOwner(r.Scheme, mgr.GetRESTMapper(), &netbirdiov1.NBRoute{})).
+		Complete(r)
+}
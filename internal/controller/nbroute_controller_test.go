@@ -0,0 +1,298 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	netbirdiov1 "github.com/netbirdio/kubernetes-operator/api/v1"
+	"github.com/netbirdio/kubernetes-operator/internal/util"
+	netbird "github.com/netbirdio/netbird/management/client/rest"
+	"github.com/netbirdio/netbird/management/server/http/api"
+)
+
+var _ = Describe("NBRoute Controller", func() {
+	Context("When reconciling a resource", func() {
+		const resourceName = "test-route"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		var mux *http.ServeMux
+		var server *httptest.Server
+		var controllerReconciler *NBRouteReconciler
+		var nbRoute *netbirdiov1.NBRoute
+
+		BeforeEach(func() {
+			mux = &http.ServeMux{}
+			server = httptest.NewServer(mux)
+			controllerReconciler = &NBRouteReconciler{
+				Client:        k8sClient,
+				Scheme:        k8sClient.Scheme(),
+				netbird:       netbird.New(server.URL, "ABC"),
+				DefaultLabels: make(map[string]string),
+			}
+
+			nbRoute = &netbirdiov1.NBRoute{
+				ObjectMeta: v1.ObjectMeta{
+					Name:      resourceName,
+					Namespace: typeNamespacedName.Namespace,
+				},
+				Spec: netbirdiov1.NBRouteSpec{
+					NetworkIdentifier: "office",
+					Network:           "10.0.0.0/16",
+					PeerGroups:        []string{"routers"},
+					Groups:            []string{"clients"},
+				},
+			}
+			Expect(k8sClient.Create(ctx, nbRoute)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			server.Close()
+
+			resource := &netbirdiov1.NBRoute{}
+			err := k8sClient.Get(ctx, typeNamespacedName, resource)
+			if !errors.IsNotFound(err) {
+				Expect(err).NotTo(HaveOccurred())
+				if len(resource.Finalizers) > 0 {
+					resource.Finalizers = nil
+					Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+				}
+				err = k8sClient.Delete(ctx, resource)
+				if !errors.IsNotFound(err) {
+					Expect(err).NotTo(HaveOccurred())
+				}
+			}
+
+			for _, name := range []string{"routers", "clients"} {
+				group := &netbirdiov1.NBGroup{}
+				err := k8sClient.Get(ctx, types.NamespacedName{Namespace: typeNamespacedName.Namespace, Name: name}, group)
+				if errors.IsNotFound(err) {
+					continue
+				}
+				Expect(err).NotTo(HaveOccurred())
+				if len(group.Finalizers) > 0 {
+					group.Finalizers = nil
+					Expect(k8sClient.Update(ctx, group)).To(Succeed())
+				}
+				err = k8sClient.Delete(ctx, group)
+				if !errors.IsNotFound(err) {
+					Expect(err).NotTo(HaveOccurred())
+				}
+			}
+		})
+
+		It("should reject routes with both network and domains", func() {
+			invalid := &netbirdiov1.NBRoute{
+				ObjectMeta: v1.ObjectMeta{
+					Name:      "invalid-route",
+					Namespace: typeNamespacedName.Namespace,
+				},
+				Spec: netbirdiov1.NBRouteSpec{
+					NetworkIdentifier: "office",
+					Network:           "10.0.0.0/16",
+					Domains:           []string{"example.com"},
+					PeerGroups:        []string{"routers"},
+					Groups:            []string{"clients"},
+				},
+			}
+			Expect(k8sClient.Create(ctx, invalid)).NotTo(Succeed())
+		})
+
+		When("Groups don't exist", func() {
+			It("should create groups and requeue", func() {
+				res, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+					NamespacedName: typeNamespacedName,
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(res.RequeueAfter).To(BeNumerically(">", 0))
+
+				for _, name := range []string{"routers", "clients"} {
+					group := &netbirdiov1.NBGroup{}
+					Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: typeNamespacedName.Namespace, Name: name}, group)).To(Succeed())
+					Expect(group.Spec.Name).To(Equal(name))
+					Expect(group.Finalizers).To(ContainElement("netbird.io/route-cleanup"))
+					Expect(group.OwnerReferences).To(HaveLen(1))
+					Expect(group.OwnerReferences[0].Kind).To(Equal("NBRoute"))
+				}
+
+				Expect(k8sClient.Get(ctx, typeNamespacedName, nbRoute)).To(Succeed())
+				Expect(nbRoute.Finalizers).To(ContainElement("netbird.io/cleanup"))
+			})
+		})
+
+		When("Groups exist", func() {
+			BeforeEach(func() {
+				for _, name := range []string{"routers", "clients"} {
+					group := &netbirdiov1.NBGroup{
+						ObjectMeta: v1.ObjectMeta{
+							Name:      name,
+							Namespace: typeNamespacedName.Namespace,
+						},
+						Spec: netbirdiov1.NBGroupSpec{
+							Name: name,
+						},
+					}
+					Expect(k8sClient.Create(ctx, group)).To(Succeed())
+					group.Status.GroupID = util.Ptr(name + "-id")
+					Expect(k8sClient.Status().Update(ctx, group)).To(Succeed())
+				}
+			})
+
+			It("should create route", func() {
+				routeCreated := false
+				mux.HandleFunc("/api/routes", func(w http.ResponseWriter, r *http.Request) {
+					defer GinkgoRecover()
+					Expect(r.Method).To(Equal(http.MethodPost))
+					routeCreated = true
+					var req api.PostApiRoutesJSONRequestBody
+					bs, err := io.ReadAll(r.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(json.Unmarshal(bs, &req)).To(Succeed())
+					Expect(req.NetworkId).To(Equal("office"))
+					Expect(req.Network).To(BeEquivalentTo(util.Ptr("10.0.0.0/16")))
+					Expect(req.Domains).To(BeNil())
+					Expect(req.Peer).To(BeNil())
+					Expect(req.PeerGroups).NotTo(BeNil())
+					Expect(*req.PeerGroups).To(ConsistOf("routers-id"))
+					Expect(req.Groups).To(ConsistOf("clients-id"))
+					Expect(req.Metric).To(Equal(9999))
+					Expect(req.Masquerade).To(BeTrue())
+					Expect(req.Enabled).To(BeTrue())
+
+					resp := api.Route{
+						Id:         "route-id",
+						NetworkId:  req.NetworkId,
+						Network:    req.Network,
+						PeerGroups: req.PeerGroups,
+						Groups:     req.Groups,
+						Metric:     req.Metric,
+						Masquerade: req.Masquerade,
+						Enabled:    req.Enabled,
+					}
+					bs, err = json.Marshal(resp)
+					Expect(err).NotTo(HaveOccurred())
+					_, err = w.Write(bs)
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+					NamespacedName: typeNamespacedName,
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(routeCreated).To(BeTrue())
+
+				Expect(k8sClient.Get(ctx, typeNamespacedName, nbRoute)).To(Succeed())
+				Expect(nbRoute.Status.RouteID).To(BeEquivalentTo(util.Ptr("route-id")))
+				Expect(nbRoute.Status.Conditions).To(HaveLen(1))
+				Expect(nbRoute.Status.Conditions[0].Status).To(BeEquivalentTo(v1.ConditionTrue))
+			})
+
+			When("Route exists", func() {
+				var route api.Route
+				routeUpdated := false
+				routeDeleted := false
+
+				BeforeEach(func() {
+					routeUpdated = false
+					routeDeleted = false
+					route = api.Route{
+						Id:         "route-id",
+						NetworkId:  "office",
+						Network:    util.Ptr("10.0.0.0/16"),
+						PeerGroups: &[]string{"routers-id"},
+						Groups:     []string{"clients-id"},
+						Metric:     9999,
+						Masquerade: true,
+						Enabled:    true,
+					}
+					mux.HandleFunc("/api/routes/route-id", func(w http.ResponseWriter, r *http.Request) {
+						defer GinkgoRecover()
+						switch r.Method {
+						case http.MethodGet:
+							bs, err := json.Marshal(route)
+							Expect(err).NotTo(HaveOccurred())
+							_, err = w.Write(bs)
+							Expect(err).NotTo(HaveOccurred())
+						case http.MethodPut:
+							routeUpdated = true
+							var req api.PutApiRoutesRouteIdJSONRequestBody
+							bs, err := io.ReadAll(r.Body)
+							Expect(err).NotTo(HaveOccurred())
+							Expect(json.Unmarshal(bs, &req)).To(Succeed())
+							route.Metric = req.Metric
+							bs, err = json.Marshal(route)
+							Expect(err).NotTo(HaveOccurred())
+							_, err = w.Write(bs)
+							Expect(err).NotTo(HaveOccurred())
+						case http.MethodDelete:
+							routeDeleted = true
+							_, err := w.Write([]byte(`{}`))
+							Expect(err).NotTo(HaveOccurred())
+						}
+					})
+
+					nbRoute.Status.RouteID = util.Ptr("route-id")
+					Expect(k8sClient.Status().Update(ctx, nbRoute)).To(Succeed())
+				})
+
+				It("should not update up-to-date route", func() {
+					_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+						NamespacedName: typeNamespacedName,
+					})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(routeUpdated).To(BeFalse())
+				})
+
+				It("should update out-of-date route", func() {
+					Expect(k8sClient.Get(ctx, typeNamespacedName, nbRoute)).To(Succeed())
+					nbRoute.Spec.Metric = 100
+					Expect(k8sClient.Update(ctx, nbRoute)).To(Succeed())
+
+					_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+						NamespacedName: typeNamespacedName,
+					})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(routeUpdated).To(BeTrue())
+					Expect(route.Metric).To(Equal(100))
+				})
+
+				It("should delete route and release groups on deletion", func() {
+					_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+						NamespacedName: typeNamespacedName,
+					})
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(k8sClient.Get(ctx, typeNamespacedName, nbRoute)).To(Succeed())
+					Expect(k8sClient.Delete(ctx, nbRoute)).To(Succeed())
+
+					_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{
+						NamespacedName: typeNamespacedName,
+					})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(routeDeleted).To(BeTrue())
+
+					group := &netbirdiov1.NBGroup{}
+					Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: typeNamespacedName.Namespace, Name: "routers"}, group)).To(Succeed())
+					Expect(group.Finalizers).NotTo(ContainElement("netbird.io/route-cleanup"))
+
+					Expect(k8sClient.Get(ctx, typeNamespacedName, nbRoute)).NotTo(Succeed())
+				})
+			})
+		})
+	})
+})
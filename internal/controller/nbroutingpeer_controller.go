@@ -2,18 +2,37 @@ package controller
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"maps"
+	"net"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/go-logr/logr"
 	netbirdiov1 "github.com/netbirdio/kubernetes-operator/api/v1"
@@ -22,6 +41,40 @@ import (
 	"github.com/netbirdio/netbird/management/server/http/api"
 )
 
+const (
+	// unhealthyRequeueAfter requeue duration while routing peers are not available or connected
+	unhealthyRequeueAfter = time.Minute
+	// clusterNameConflictGracePeriod duration foreign peers must stay connected to the routing peer group before reporting a cluster name conflict
+	clusterNameConflictGracePeriod = 5 * time.Minute
+	// routingPeerHostnameAnnotation routing peer Pod annotation overriding the expected NetBird peer hostname
+	routingPeerHostnameAnnotation = "netbird.io/peer-hostname"
+	// routingPeerDaemonVolume volume sharing the NetBird daemon socket with the metrics exporter
+	routingPeerDaemonVolume = "netbird-daemon"
+	// routingPeerDaemonDir mount path of routingPeerDaemonVolume
+	routingPeerDaemonDir = "/var/run/netbird"
+	// routingPeerStateVolume StatefulSet volume claim persisting the NetBird client state
+	routingPeerStateVolume = "netbird-state"
+	// routingPeerStateDir mount path of routingPeerStateVolume
+	routingPeerStateDir = "/var/lib/netbird"
+	// clusterCIDRLabel NBResource label referencing the NBRoutingPeer exposing a cluster IP range
+	clusterCIDRLabel = "netbird.io/cluster-cidr"
+)
+
+var (
+	serviceMonitorGVK = schema.GroupVersionKind{
+		Group:   "monitoring.coreos.com",
+		Version: "v1",
+		Kind:    "ServiceMonitor",
+	}
+	// serviceCIDRGVKs ServiceCIDR list kinds, by preference
+	serviceCIDRGVKs = []schema.GroupVersionKind{
+		{Group: "networking.k8s.io", Version: "v1", Kind: "ServiceCIDRList"},
+		{Group: "networking.k8s.io", Version: "v1beta1", Kind: "ServiceCIDRList"},
+	}
+	// zoneNameRegexp matches characters of zone names not allowed in NBRoutingPeer names
+	zoneNameRegexp = regexp.MustCompile(`[^a-z0-9-]+`)
+)
+
 // NBRoutingPeerReconciler reconciles a NBRoutingPeer object
 type NBRoutingPeerReconciler struct {
 	client.Client
@@ -32,6 +85,8 @@ type NBRoutingPeerReconciler struct {
 	ManagementURL      string
 	NamespacedNetworks bool
 	DefaultLabels      map[string]string
+	DefaultRouterName  string
+	Recorder           record.EventRecorder
 	netbird            *netbird.Client
 }
 
@@ -79,12 +134,30 @@ func (r *NBRoutingPeerReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 		return r.handleDelete(ctx, req, nbrp, logger)
 	}
 
+	logger.Info("NBRoutingPeer: Checking template")
+	updated, err := r.handleTemplate(ctx, nbrp, logger)
+	if err != nil || updated {
+		return ctrl.Result{}, err
+	}
+
 	logger.Info("NBRoutingPeer: Checking network")
 	err = r.handleNetwork(ctx, req, nbrp, logger)
 	if err != nil {
 		return ctrl.Result{}, err
 	}
 
+	if nbrp.Spec.Topology != nil {
+		logger.Info("NBRoutingPeer: Checking zones")
+		err = r.handleTopology(ctx, req, nbrp, logger)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		logger.Info("NBRoutingPeer: Checking cluster CIDRs")
+		err = r.handleClusterCIDRs(ctx, nbrp, logger)
+		return ctrl.Result{}, err
+	}
+
 	logger.Info("NBRoutingPeer: Checking groups")
 	nbGroup, result, err := r.handleGroup(ctx, req, nbrp, logger)
 	if nbGroup == nil {
@@ -98,21 +171,522 @@ func (r *NBRoutingPeerReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 	}
 
 	logger.Info("NBRoutingPeer: Checking network router")
-	err = r.handleRouter(ctx, nbrp, *nbGroup, logger)
+	err = r.handleRouter(ctx, req, nbrp, *nbGroup, logger)
 	if err != nil {
 		return ctrl.Result{}, err
 	}
 
-	logger.Info("NBRoutingPeer: Checking deployment")
-	err = r.handleDeployment(ctx, req, nbrp, logger)
+	logger.Info("NBRoutingPeer: Checking exit node")
+	err = r.handleExitNode(ctx, nbrp, *nbGroup, logger)
 	if err != nil {
 		return ctrl.Result{}, err
 	}
 
-	nbrp.Status.Conditions = netbirdiov1.NBConditionTrue()
+	logger.Info("NBRoutingPeer: Checking workload")
+	err = r.handleWorkload(ctx, req, nbrp, logger)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	logger.Info("NBRoutingPeer: Checking metrics")
+	err = r.handleMetrics(ctx, nbrp, logger)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	logger.Info("NBRoutingPeer: Checking cluster CIDRs")
+	err = r.handleClusterCIDRs(ctx, nbrp, logger)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	groupPeers, err := r.listGroupPeers(ctx, nbrp, *nbGroup, logger)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if !routingPeerEphemeral(nbrp) {
+		logger.Info("NBRoutingPeer: Checking stale peers")
+		groupPeers, err = r.handleStalePeers(ctx, req, nbrp, groupPeers, logger)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	logger.Info("NBRoutingPeer: Checking routing peer health")
+	return r.handleHealth(ctx, req, nbrp, groupPeers, logger)
+}
+
+// listGroupPeers lists NetBird peers registered in the routing peer group
+func (r *NBRoutingPeerReconciler) listGroupPeers(ctx context.Context, nbrp *netbirdiov1.NBRoutingPeer, nbGroup netbirdiov1.NBGroup, logger logr.Logger) ([]api.Peer, error) {
+	peers, err := r.netbird.Peers.List(ctx)
+	if err != nil {
+		logger.Error(errNetBirdAPI, "error listing peers", "err", err)
+		nbrp.Status.Conditions = netbirdiov1.NBConditionFalse("APIError", fmt.Sprintf("error listing peers: %v", err))
+		return nil, err
+	}
+
+	var groupPeers []api.Peer
+	for _, p := range peers {
+		for _, g := range p.Groups {
+			if g.Id == *nbGroup.Status.GroupID {
+				groupPeers = append(groupPeers, p)
+				break
+			}
+		}
+	}
+
+	return groupPeers, nil
+}
+
+// handleStalePeers deletes disconnected peers in the routing peer group that don't belong to a live routing peer pod
+func (r *NBRoutingPeerReconciler) handleStalePeers(ctx context.Context, req ctrl.Request, nbrp *netbirdiov1.NBRoutingPeer, groupPeers []api.Peer, logger logr.Logger) ([]api.Peer, error) {
+	workload, err := r.routingPeerWorkload(ctx, req, nbrp, logger)
+	if err != nil {
+		return nil, err
+	}
+	if workload == nil {
+		// Workload not created yet, pods can't be matched to peers
+		return groupPeers, nil
+	}
+
+	var podList corev1.PodList
+	err = r.Client.List(ctx, &podList, client.InNamespace(req.Namespace), client.MatchingLabels(routingPeerSelector(nbrp)))
+	if err != nil {
+		logger.Error(errKubernetesAPI, "error listing Pods", "err", err)
+		nbrp.Status.Conditions = netbirdiov1.NBConditionFalse("internalError", fmt.Sprintf("error listing pods: %v", err))
+		return nil, err
+	}
+
+	hostnames := routingPeerHostnames(podList.Items, false)
+
+	livePeers := make([]api.Peer, 0, len(groupPeers))
+	for _, p := range groupPeers {
+		if _, ok := hostnames[p.Hostname]; ok || p.Connected {
+			livePeers = append(livePeers, p)
+			continue
+		}
+
+		logger.Info("Deleting stale routing peer", "peer-id", p.Id, "hostname", p.Hostname)
+		err = r.netbird.Peers.Delete(ctx, p.Id)
+		if err != nil && !strings.Contains(err.Error(), "not found") {
+			logger.Error(errNetBirdAPI, "error deleting peer", "err", err)
+			nbrp.Status.Conditions = netbirdiov1.NBConditionFalse("APIError", fmt.Sprintf("error deleting peer: %v", err))
+			return nil, err
+		}
+	}
+
+	return livePeers, nil
+}
+
+// routingPeerHostnames hostnames NetBird peers of routing peer pods register with
+func routingPeerHostnames(pods []corev1.Pod, includeTerminating bool) map[string]interface{} {
+	hostnames := make(map[string]interface{})
+	for _, pod := range pods {
+		if pod.DeletionTimestamp != nil && !includeTerminating {
+			continue
+		}
+		hostnames[pod.Name] = nil
+		if v, ok := pod.Annotations[routingPeerHostnameAnnotation]; ok {
+			hostnames[v] = nil
+		}
+	}
+	return hostnames
+}
+
+// clusterNameConflict whether connected peers of the routing peer group can't all be routing peer pods of this cluster,
+// meaning another cluster with the same cluster name registers routing peers in the same group.
+// Peers last seen before the newest pod started belong to pods replaced since, and are ignored.
+func clusterNameConflict(groupPeers []api.Peer, pods []corev1.Pod) bool {
+	hostnames := routingPeerHostnames(pods, true)
+	var newestStart time.Time
+	for _, pod := range pods {
+		if pod.Status.StartTime != nil && pod.Status.StartTime.After(newestStart) {
+			newestStart = pod.Status.StartTime.Time
+		}
+	}
+
+	connected := 0
+	for _, p := range groupPeers {
+		if !p.Connected || p.LastSeen.Before(newestStart) {
+			continue
+		}
+		connected++
+		if _, ok := hostnames[p.Hostname]; !ok {
+			return true
+		}
+	}
+	// StatefulSet pods of clusters with the same cluster name share hostnames
+	return connected > len(pods)
+}
+
+// clusterNameConflictCondition reports a suspected cluster name conflict once it persisted for clusterNameConflictGracePeriod,
+// as peers of pods removed by rolling updates or scale down still show as connected for a while
+func clusterNameConflictCondition(existing []netbirdiov1.NBCondition, suspected bool, clusterName string, now time.Time) netbirdiov1.NBCondition {
+	if !suspected {
+		return routingPeerCondition(netbirdiov1.NBRoutingPeerClusterNameConflict, false, "NoConflict", "all connected peers belong to this cluster")
+	}
+
+	for _, c := range existing {
+		if c.Type != netbirdiov1.NBRoutingPeerClusterNameConflict {
+			continue
+		}
+		if c.Status == corev1.ConditionTrue || (c.Reason == "ConflictSuspected" && now.Sub(c.LastTransitionTime.Time) >= clusterNameConflictGracePeriod) {
+			return routingPeerCondition(netbirdiov1.NBRoutingPeerClusterNameConflict, true, "ClusterNameConflict",
+				fmt.Sprintf("peers of another cluster named %q register in the routing peer group, cluster names must be unique", clusterName))
+		}
+	}
+
+	return routingPeerCondition(netbirdiov1.NBRoutingPeerClusterNameConflict, false, "ConflictSuspected",
+		"connected peers not belonging to this cluster, waiting for peers of removed pods to disconnect")
+}
+
+// handleHealth reports routing peer workload replicas and NetBird peers registered in the routing peer group
+func (r *NBRoutingPeerReconciler) handleHealth(ctx context.Context, req ctrl.Request, nbrp *netbirdiov1.NBRoutingPeer, groupPeers []api.Peer, logger logr.Logger) (ctrl.Result, error) {
+	workload, err := r.routingPeerWorkload(ctx, req, nbrp, logger)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if workload == nil {
+		workload = &routingPeerWorkloadStatus{}
+	}
+
+	nbrp.Status.Replicas = workload.Replicas
+	nbrp.Status.ReadyReplicas = workload.ReadyReplicas
+
+	statusPeers := make([]netbirdiov1.NBRoutingPeerPeer, 0, len(groupPeers))
+	var connectedPeers int32
+	for _, p := range groupPeers {
+		statusPeers = append(statusPeers, netbirdiov1.NBRoutingPeerPeer{
+			ID:        p.Id,
+			Hostname:  p.Hostname,
+			Ordinal:   routingPeerOrdinal(nbrp, p.Hostname),
+			IP:        p.Ip,
+			Connected: p.Connected,
+			// API server stores timestamps with second precision
+			LastSeen: v1.NewTime(p.LastSeen).Rfc3339Copy(),
+		})
+		if p.Connected {
+			connectedPeers++
+		}
+	}
+	slices.SortFunc(statusPeers, func(a, b netbirdiov1.NBRoutingPeerPeer) int {
+		return strings.Compare(a.Hostname+a.ID, b.Hostname+b.ID)
+	})
+	nbrp.Status.Peers = statusPeers
+	nbrp.Status.ConnectedPeers = connectedPeers
+
+	deploymentAvailable := workload.AvailableReplicas > 0
+	deploymentReason := "DeploymentAvailable"
+	if !deploymentAvailable {
+		deploymentReason = "DeploymentUnavailable"
+	}
+	deploymentCondition := routingPeerCondition(netbirdiov1.NBRoutingPeerDeploymentAvailable, deploymentAvailable, deploymentReason,
+		fmt.Sprintf("%d/%d replicas available", workload.AvailableReplicas, nbrp.Status.Replicas))
+
+	peersConnected := connectedPeers > 0
+	peersReason := "PeersConnected"
+	if !peersConnected {
+		peersReason = "NoPeersConnected"
+	}
+	peersCondition := routingPeerCondition(netbirdiov1.NBRoutingPeerPeersConnected, peersConnected, peersReason,
+		fmt.Sprintf("%d/%d peers connected", connectedPeers, len(statusPeers)))
+
+	suspectedConflict := false
+	if workload.Replicas > 0 {
+		var podList corev1.PodList
+		err = r.Client.List(ctx, &podList, client.InNamespace(req.Namespace), client.MatchingLabels(routingPeerSelector(nbrp)))
+		if err != nil {
+			logger.Error(errKubernetesAPI, "error listing Pods", "err", err)
+			nbrp.Status.Conditions = netbirdiov1.NBConditionFalse("internalError", fmt.Sprintf("error listing pods: %v", err))
+			return ctrl.Result{}, err
+		}
+		suspectedConflict = clusterNameConflict(groupPeers, podList.Items)
+	}
+	conflictCondition := clusterNameConflictCondition(nbrp.Status.Conditions, suspectedConflict, r.ClusterName, time.Now())
+	conflict := conflictCondition.Status == corev1.ConditionTrue
+	if conflict {
+		logger.Error(errInvalidValue, "routing peer group contains peers of another cluster with the same cluster name", "cluster", r.ClusterName)
+	}
+
+	ready := deploymentAvailable && peersConnected && !conflict
+	readyReason := "RoutingPeerReady"
+	if conflict {
+		readyReason = "ClusterNameConflict"
+	} else if !ready {
+		readyReason = "RoutingPeerUnhealthy"
+	}
+	readyCondition := routingPeerCondition(netbirdiov1.NBRoutingPeerReady, ready, readyReason,
+		fmt.Sprintf("%d/%d replicas available, %d/%d peers connected", workload.AvailableReplicas, nbrp.Status.Replicas, connectedPeers, len(statusPeers)))
+
+	nbrp.Status.Conditions = keepConditionTimes(nbrp.Status.Conditions, []netbirdiov1.NBCondition{readyCondition, deploymentCondition, peersCondition, conflictCondition})
+
+	if !deploymentAvailable || !peersConnected || (suspectedConflict && !conflict) {
+		// Peer connectivity is not watched, check again sooner until routing peers are healthy
+		return ctrl.Result{RequeueAfter: unhealthyRequeueAfter}, nil
+	}
+
 	return ctrl.Result{}, nil
 }
 
+// routingPeerCondition returns condition of conditionType with status based on ok
+func routingPeerCondition(conditionType netbirdiov1.NBConditionType, ok bool, reason, msg string) netbirdiov1.NBCondition {
+	status := corev1.ConditionFalse
+	if ok {
+		status = corev1.ConditionTrue
+	}
+	return netbirdiov1.NBCondition{
+		Type:               conditionType,
+		LastProbeTime:      v1.Now(),
+		LastTransitionTime: v1.Now(),
+		Status:             status,
+		Reason:             reason,
+		Message:            msg,
+	}
+}
+
+// keepConditionTimes keeps probe and transition times of conditions with unchanged status and reason,
+// messages carrying live counts don't count as a transition
+func keepConditionTimes(existing, conditions []netbirdiov1.NBCondition) []netbirdiov1.NBCondition {
+	for i, c := range conditions {
+		for _, e := range existing {
+			if e.Type == c.Type && e.Status == c.Status && e.Reason == c.Reason {
+				conditions[i].LastProbeTime = e.LastProbeTime
+				conditions[i].LastTransitionTime = e.LastTransitionTime
+			}
+		}
+	}
+	return conditions
+}
+
+// routingPeerWorkloadStatus replicas of the routing peer Deployment or StatefulSet
+type routingPeerWorkloadStatus struct {
+	Replicas          int32
+	ReadyReplicas     int32
+	AvailableReplicas int32
+}
+
+// routingPeerWorkload returns status of the routing peer workload, nil if not created yet
+func (r *NBRoutingPeerReconciler) routingPeerWorkload(ctx context.Context, req ctrl.Request, nbrp *netbirdiov1.NBRoutingPeer, logger logr.Logger) (*routingPeerWorkloadStatus, error) {
+	var workloadObject client.Object = &appsv1.Deployment{}
+	if routingPeerStateful(nbrp) {
+		workloadObject = &appsv1.StatefulSet{}
+	}
+
+	err := r.Client.Get(ctx, req.NamespacedName, workloadObject)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		logger.Error(errKubernetesAPI, "error getting routing peer workload", "err", err)
+		nbrp.Status.Conditions = netbirdiov1.NBConditionFalse("internalError", fmt.Sprintf("error getting routing peer workload: %v", err))
+		return nil, err
+	}
+
+	status := routingPeerWorkloadStatus{}
+	switch w := workloadObject.(type) {
+	case *appsv1.Deployment:
+		if w.Spec.Replicas != nil {
+			status.Replicas = *w.Spec.Replicas
+		}
+		status.ReadyReplicas = w.Status.ReadyReplicas
+		status.AvailableReplicas = w.Status.AvailableReplicas
+	case *appsv1.StatefulSet:
+		if w.Spec.Replicas != nil {
+			status.Replicas = *w.Spec.Replicas
+		}
+		status.ReadyReplicas = w.Status.ReadyReplicas
+		status.AvailableReplicas = w.Status.AvailableReplicas
+	}
+
+	return &status, nil
+}
+
+// routingPeerStateful whether routing peers run in a StatefulSet
+func routingPeerStateful(nbrp *netbirdiov1.NBRoutingPeer) bool {
+	return nbrp.Spec.WorkloadType == netbirdiov1.NBRoutingPeerWorkloadStatefulSet
+}
+
+// routingPeerOrdinal StatefulSet ordinal of routing peer pod with hostname, nil for Deployment pods
+func routingPeerOrdinal(nbrp *netbirdiov1.NBRoutingPeer, hostname string) *int32 {
+	if !routingPeerStateful(nbrp) {
+		return nil
+	}
+
+	suffix, ok := strings.CutPrefix(hostname, nbrp.Name+"-")
+	if !ok {
+		return nil
+	}
+	ordinal, err := strconv.ParseInt(suffix, 10, 32)
+	if err != nil {
+		return nil
+	}
+
+	return util.Ptr(int32(ordinal))
+}
+
+// handleWorkload reconcile routing peer Deployment or StatefulSet, removing the workload of the other type
+func (r *NBRoutingPeerReconciler) handleWorkload(ctx context.Context, req ctrl.Request, nbrp *netbirdiov1.NBRoutingPeer, logger logr.Logger) error {
+	var staleWorkload client.Object = &appsv1.StatefulSet{}
+	if routingPeerStateful(nbrp) {
+		staleWorkload = &appsv1.Deployment{}
+	}
+
+	err := r.Client.Get(ctx, req.NamespacedName, staleWorkload)
+	if err != nil && !errors.IsNotFound(err) {
+		logger.Error(errKubernetesAPI, "error getting routing peer workload", "err", err)
+		nbrp.Status.Conditions = netbirdiov1.NBConditionFalse("internalError", fmt.Sprintf("error getting routing peer workload: %v", err))
+		return err
+	}
+	if err == nil {
+		logger.Info("Deleting routing peer workload of previous type", "name", staleWorkload.GetName())
+		err = r.Client.Delete(ctx, staleWorkload)
+		if err != nil && !errors.IsNotFound(err) {
+			logger.Error(errKubernetesAPI, "error deleting routing peer workload", "err", err)
+			nbrp.Status.Conditions = netbirdiov1.NBConditionFalse("internalError", fmt.Sprintf("error deleting routing peer workload: %v", err))
+			return err
+		}
+	}
+
+	if routingPeerStateful(nbrp) {
+		return r.handleStatefulSet(ctx, req, nbrp, logger)
+	}
+	return r.handleDeployment(ctx, req, nbrp, logger)
+}
+
+// handleStatefulSet reconcile routing peer StatefulSet
+func (r *NBRoutingPeerReconciler) handleStatefulSet(ctx context.Context, req ctrl.Request, nbrp *netbirdiov1.NBRoutingPeer, logger logr.Logger) error {
+	routingPeerStatefulSet := appsv1.StatefulSet{}
+	err := r.Client.Get(ctx, req.NamespacedName, &routingPeerStatefulSet)
+	if err != nil && !errors.IsNotFound(err) {
+		logger.Error(errKubernetesAPI, "error getting StatefulSet", "err", err)
+		nbrp.Status.Conditions = netbirdiov1.NBConditionFalse("internalError", fmt.Sprintf("error getting StatefulSet: %v", err))
+		return err
+	}
+
+	labels := make(map[string]string)
+	maps.Copy(labels, r.DefaultLabels)
+	maps.Copy(labels, nbrp.Spec.Labels)
+	podLabels := maps.Clone(labels)
+	maps.Copy(podLabels, routingPeerSelector(nbrp))
+
+	var replicas int32 = 3
+	if nbrp.Spec.Replicas != nil {
+		replicas = *nbrp.Spec.Replicas
+	}
+
+	ownerReferences := []v1.OwnerReference{
+		{
+			APIVersion:         netbirdiov1.GroupVersion.Identifier(),
+			Kind:               "NBRoutingPeer",
+			Name:               nbrp.Name,
+			UID:                nbrp.UID,
+			BlockOwnerDeletion: util.Ptr(true),
+		},
+	}
+	template := corev1.PodTemplateSpec{
+		ObjectMeta: v1.ObjectMeta{
+			Labels: podLabels,
+		},
+		Spec: corev1.PodSpec{
+			NodeSelector: nbrp.Spec.NodeSelector,
+			Tolerations:  nbrp.Spec.Tolerations,
+			Containers:   r.routingPeerContainers(nbrp),
+			Volumes:      routingPeerVolumes(nbrp),
+		},
+	}
+
+	// Create StatefulSet
+	if errors.IsNotFound(err) {
+		storageSize := resource.MustParse("10Mi")
+		var storageClassName *string
+		if nbrp.Spec.StatefulSet != nil {
+			storageClassName = nbrp.Spec.StatefulSet.StorageClassName
+			if !nbrp.Spec.StatefulSet.Size.IsZero() {
+				storageSize = nbrp.Spec.StatefulSet.Size
+			}
+		}
+
+		routingPeerStatefulSet = appsv1.StatefulSet{
+			ObjectMeta: v1.ObjectMeta{
+				Name:            nbrp.Name,
+				Namespace:       nbrp.Namespace,
+				OwnerReferences: ownerReferences,
+				Labels:          labels,
+				Annotations:     nbrp.Spec.Annotations,
+			},
+			Spec: appsv1.StatefulSetSpec{
+				Replicas:    &replicas,
+				ServiceName: nbrp.Name,
+				Selector: &v1.LabelSelector{
+					MatchLabels: routingPeerSelector(nbrp),
+				},
+				Template: template,
+				VolumeClaimTemplates: []corev1.PersistentVolumeClaim{
+					{
+						ObjectMeta: v1.ObjectMeta{
+							Name: routingPeerStateVolume,
+						},
+						Spec: corev1.PersistentVolumeClaimSpec{
+							AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+							StorageClassName: storageClassName,
+							Resources: corev1.VolumeResourceRequirements{
+								Requests: corev1.ResourceList{
+									corev1.ResourceStorage: storageSize,
+								},
+							},
+						},
+					},
+				},
+				// Client state outlives scale down to keep identities of re-added replicas,
+				// and is removed with the routing peer
+				PersistentVolumeClaimRetentionPolicy: &appsv1.StatefulSetPersistentVolumeClaimRetentionPolicy{
+					WhenDeleted: appsv1.DeletePersistentVolumeClaimRetentionPolicyType,
+					WhenScaled:  appsv1.RetainPersistentVolumeClaimRetentionPolicyType,
+				},
+			},
+		}
+
+		err = r.Client.Create(ctx, &routingPeerStatefulSet)
+		if err != nil {
+			logger.Error(errKubernetesAPI, "error creating StatefulSet", "err", err)
+			nbrp.Status.Conditions = netbirdiov1.NBConditionFalse("internalError", fmt.Sprintf("error creating StatefulSet: %v", err))
+			return err
+		}
+		return nil
+	}
+
+	// Volume claim templates are immutable, storage changes only apply to new StatefulSets
+	updatedStatefulSet := routingPeerStatefulSet.DeepCopy()
+	updatedStatefulSet.ObjectMeta.OwnerReferences = ownerReferences
+	updatedStatefulSet.ObjectMeta.Labels = labels
+	if updatedStatefulSet.ObjectMeta.Annotations == nil && len(nbrp.Spec.Annotations) > 0 {
+		updatedStatefulSet.ObjectMeta.Annotations = make(map[string]string)
+	}
+	maps.Copy(updatedStatefulSet.ObjectMeta.Annotations, nbrp.Spec.Annotations)
+	updatedStatefulSet.Spec.Replicas = &replicas
+	updatedStatefulSet.Spec.Template.ObjectMeta.Labels = podLabels
+	updatedStatefulSet.Spec.Template.Spec.Tolerations = nbrp.Spec.Tolerations
+	updatedStatefulSet.Spec.Template.Spec.NodeSelector = nbrp.Spec.NodeSelector
+	updatedStatefulSet.Spec.Template.Spec.Volumes = routingPeerVolumes(nbrp)
+	updatedStatefulSet.Spec.Template.Spec.Containers = mergeContainers(updatedStatefulSet.Spec.Template.Spec.Containers, r.routingPeerContainers(nbrp))
+
+	patch := client.StrategicMergeFrom(&routingPeerStatefulSet)
+	bs, _ := patch.Data(updatedStatefulSet)
+	// Minimum patch size is 2 for "{}"
+	if len(bs) <= 2 {
+		return nil
+	}
+	err = r.Client.Patch(ctx, updatedStatefulSet, patch)
+	if err != nil {
+		logger.Error(errKubernetesAPI, "error updating StatefulSet", "err", err)
+		nbrp.Status.Conditions = netbirdiov1.NBConditionFalse("internalError", fmt.Sprintf("error updating StatefulSet: %v", err))
+		return err
+	}
+
+	return nil
+}
+
 // handleDeployment reconcile routing peer Deployment
 func (r *NBRoutingPeerReconciler) handleDeployment(ctx context.Context, req ctrl.Request, nbrp *netbirdiov1.NBRoutingPeer, logger logr.Logger) error {
 	routingPeerDeployment := appsv1.Deployment{}
@@ -123,12 +697,11 @@ func (r *NBRoutingPeerReconciler) handleDeployment(ctx context.Context, req ctrl
 		return err
 	}
 
-	labels := r.DefaultLabels
-	for k, v := range nbrp.Spec.Labels {
-		labels[k] = v
-	}
-	podLabels := labels
-	podLabels["app.kubernetes.io/name"] = "netbird-router"
+	labels := make(map[string]string)
+	maps.Copy(labels, r.DefaultLabels)
+	maps.Copy(labels, nbrp.Spec.Labels)
+	podLabels := maps.Clone(labels)
+	maps.Copy(podLabels, routingPeerSelector(nbrp))
 
 	// Create deployment
 	if errors.IsNotFound(err) {
@@ -155,9 +728,7 @@ func (r *NBRoutingPeerReconciler) handleDeployment(ctx context.Context, req ctrl
 			Spec: appsv1.DeploymentSpec{
 				Replicas: &replicas,
 				Selector: &v1.LabelSelector{
-					MatchLabels: map[string]string{
-						"app.kubernetes.io/name": "netbird-router",
-					},
+					MatchLabels: routingPeerSelector(nbrp),
 				},
 				Template: corev1.PodTemplateSpec{
 					ObjectMeta: v1.ObjectMeta{
@@ -166,39 +737,8 @@ func (r *NBRoutingPeerReconciler) handleDeployment(ctx context.Context, req ctrl
 					Spec: corev1.PodSpec{
 						NodeSelector: nbrp.Spec.NodeSelector,
 						Tolerations:  nbrp.Spec.Tolerations,
-						Containers: []corev1.Container{
-							{
-								Name:  "netbird",
-								Image: r.ClientImage,
-								Env: []corev1.EnvVar{
-									{
-										Name: "NB_SETUP_KEY",
-										ValueFrom: &corev1.EnvVarSource{
-											SecretKeyRef: &corev1.SecretKeySelector{
-												LocalObjectReference: corev1.LocalObjectReference{
-													Name: nbrp.Name,
-												},
-												Key: "setupKey",
-											},
-										},
-									},
-									{
-										Name:  "NB_MANAGEMENT_URL",
-										Value: r.ManagementURL,
-									},
-								},
-								SecurityContext: &corev1.SecurityContext{
-									Capabilities: &corev1.Capabilities{
-										Add: []corev1.Capability{
-											"NET_ADMIN",
-										},
-									},
-								},
-								Resources:    nbrp.Spec.Resources,
-								VolumeMounts: nbrp.Spec.VolumeMounts,
-							},
-						},
-						Volumes: nbrp.Spec.Volumes,
+						Containers:   r.routingPeerContainers(nbrp),
+						Volumes:      routingPeerVolumes(nbrp),
 					},
 				},
 			},
@@ -224,32 +764,84 @@ func (r *NBRoutingPeerReconciler) handleDeployment(ctx context.Context, req ctrl
 			},
 		}
 		updatedDeployment.ObjectMeta.Labels = labels
-		for k, v := range nbrp.Spec.Annotations {
-			updatedDeployment.ObjectMeta.Annotations[k] = nbrp.Spec.Annotations[v]
+		if updatedDeployment.ObjectMeta.Annotations == nil && len(nbrp.Spec.Annotations) > 0 {
+			updatedDeployment.ObjectMeta.Annotations = make(map[string]string)
 		}
+		maps.Copy(updatedDeployment.ObjectMeta.Annotations, nbrp.Spec.Annotations)
 		var replicas int32 = 3
 		if nbrp.Spec.Replicas != nil {
 			replicas = *nbrp.Spec.Replicas
 		}
 		updatedDeployment.Spec.Replicas = &replicas
-		updatedDeployment.Spec.Selector = &v1.LabelSelector{
-			MatchLabels: map[string]string{
-				"app.kubernetes.io/name": "netbird-router",
-			},
-		}
+		// Selector is immutable, Deployments created by earlier versions keep selecting pods by
+		// app.kubernetes.io/name only, which pod labels still carry
 		updatedDeployment.Spec.Template.Spec.Tolerations = nbrp.Spec.Tolerations
 		updatedDeployment.Spec.Template.Spec.NodeSelector = nbrp.Spec.NodeSelector
 		updatedDeployment.Spec.Template.ObjectMeta.Labels = podLabels
-		updatedDeployment.Spec.Template.Spec.Volumes = nbrp.Spec.Volumes
-		updatedDeployment.Spec.Template.ObjectMeta.Labels = map[string]string{
-			"app.kubernetes.io/name": "netbird-router",
+		updatedDeployment.Spec.Template.Spec.Volumes = routingPeerVolumes(nbrp)
+		updatedDeployment.Spec.Template.Spec.Containers = mergeContainers(updatedDeployment.Spec.Template.Spec.Containers, r.routingPeerContainers(nbrp))
+
+		patch := client.StrategicMergeFrom(&routingPeerDeployment)
+		bs, _ := patch.Data(updatedDeployment)
+		// To ensure no useless patching is done to the deployment being watched
+		// Minimum patch size is 2 for "{}"
+		if len(bs) <= 2 {
+			return nil
 		}
-		if len(updatedDeployment.Spec.Template.Spec.Containers) != 1 {
-			updatedDeployment.Spec.Template.Spec.Containers = []corev1.Container{}
+		err = r.Client.Patch(ctx, updatedDeployment, patch)
+		if err != nil {
+			logger.Error(errKubernetesAPI, "error updating Deployment", "err", err)
+			nbrp.Status.Conditions = netbirdiov1.NBConditionFalse("internalError", fmt.Sprintf("error updating Deployment: %v", err))
+			return err
 		}
-		updatedDeployment.Spec.Template.Spec.Containers[0].Name = "netbird"
-		updatedDeployment.Spec.Template.Spec.Containers[0].Image = r.ClientImage
-		updatedDeployment.Spec.Template.Spec.Containers[0].Env = []corev1.EnvVar{
+	}
+
+	return nil
+}
+
+// routingPeerPodsSelector labels selecting all routing peer pods of nbrp, including pods of per-zone routing peers
+func routingPeerPodsSelector(nbrp *netbirdiov1.NBRoutingPeer) map[string]string {
+	if nbrp.Spec.Topology == nil {
+		return routingPeerSelector(nbrp)
+	}
+	return map[string]string{
+		"app.kubernetes.io/name":               "netbird-router",
+		netbirdiov1.NBRoutingPeerTopologyLabel: nbrp.Name,
+	}
+}
+
+// routingPeerSelector labels selecting routing peer pods
+func routingPeerSelector(nbrp *netbirdiov1.NBRoutingPeer) map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/name":     "netbird-router",
+		"app.kubernetes.io/instance": nbrp.Name,
+	}
+}
+
+// defaultNetworkName name of the routing peer network and group, unique per NBRoutingPeer
+func (r *NBRoutingPeerReconciler) defaultNetworkName(nbrp *netbirdiov1.NBRoutingPeer) string {
+	networkName := r.ClusterName
+	if r.NamespacedNetworks {
+		networkName += "-" + nbrp.Namespace
+	}
+
+	defaultRouterName := r.DefaultRouterName
+	if defaultRouterName == "" {
+		defaultRouterName = "router"
+	}
+	if nbrp.Name != defaultRouterName {
+		networkName += "-" + nbrp.Name
+	}
+
+	return networkName
+}
+
+// routingPeerContainers desired containers of routing peer pods
+func (r *NBRoutingPeerReconciler) routingPeerContainers(nbrp *netbirdiov1.NBRoutingPeer) []corev1.Container {
+	netbirdContainer := corev1.Container{
+		Name:  "netbird",
+		Image: r.ClientImage,
+		Env: []corev1.EnvVar{
 			{
 				Name: "NB_SETUP_KEY",
 				ValueFrom: &corev1.EnvVarSource{
@@ -265,28 +857,303 @@ func (r *NBRoutingPeerReconciler) handleDeployment(ctx context.Context, req ctrl
 				Name:  "NB_MANAGEMENT_URL",
 				Value: r.ManagementURL,
 			},
-		}
-		updatedDeployment.Spec.Template.Spec.Containers[0].SecurityContext = &corev1.SecurityContext{
+		},
+		SecurityContext: &corev1.SecurityContext{
 			Capabilities: &corev1.Capabilities{
 				Add: []corev1.Capability{
 					"NET_ADMIN",
 				},
 			},
+		},
+		Resources:    nbrp.Spec.Resources,
+		VolumeMounts: nbrp.Spec.VolumeMounts,
+	}
+
+	if routingPeerStateful(nbrp) {
+		// Persist client config, including the WireGuard private key, in the per-replica volume claim
+		netbirdContainer.Env = append(netbirdContainer.Env, corev1.EnvVar{
+			Name:  "NB_CONFIG",
+			Value: routingPeerStateDir + "/config.json",
+		})
+		netbirdContainer.VolumeMounts = append(slices.Clone(netbirdContainer.VolumeMounts), corev1.VolumeMount{
+			Name:      routingPeerStateVolume,
+			MountPath: routingPeerStateDir,
+		})
+	}
+
+	if nbrp.Spec.Metrics == nil {
+		return []corev1.Container{netbirdContainer}
+	}
+
+	// Share daemon socket with metrics exporter
+	daemonAddr := corev1.EnvVar{
+		Name:  "NB_DAEMON_ADDR",
+		Value: "unix://" + routingPeerDaemonDir + "/netbird.sock",
+	}
+	daemonMount := corev1.VolumeMount{
+		Name:      routingPeerDaemonVolume,
+		MountPath: routingPeerDaemonDir,
+	}
+	netbirdContainer.Env = append(netbirdContainer.Env, daemonAddr)
+	netbirdContainer.VolumeMounts = append(slices.Clone(netbirdContainer.VolumeMounts), daemonMount)
+
+	metricsContainer := corev1.Container{
+		Name:  "metrics",
+		Image: nbrp.Spec.Metrics.Image,
+		Env: []corev1.EnvVar{
+			daemonAddr,
+			{
+				Name:  "METRICS_PORT",
+				Value: fmt.Sprintf("%d", routingPeerMetricsPort(nbrp)),
+			},
+		},
+		Ports: []corev1.ContainerPort{
+			{
+				Name:          "metrics",
+				ContainerPort: routingPeerMetricsPort(nbrp),
+				Protocol:      corev1.ProtocolTCP,
+			},
+		},
+		Resources:    nbrp.Spec.Metrics.Resources,
+		VolumeMounts: []corev1.VolumeMount{daemonMount},
+	}
+
+	return []corev1.Container{netbirdContainer, metricsContainer}
+}
+
+// routingPeerVolumes desired volumes of routing peer pods
+func routingPeerVolumes(nbrp *netbirdiov1.NBRoutingPeer) []corev1.Volume {
+	if nbrp.Spec.Metrics == nil {
+		return nbrp.Spec.Volumes
+	}
+
+	return append(slices.Clone(nbrp.Spec.Volumes), corev1.Volume{
+		Name: routingPeerDaemonVolume,
+		VolumeSource: corev1.VolumeSource{
+			EmptyDir: &corev1.EmptyDirVolumeSource{},
+		},
+	})
+}
+
+// routingPeerMetricsPort metrics exporter port, defaults to 9090
+func routingPeerMetricsPort(nbrp *netbirdiov1.NBRoutingPeer) int32 {
+	if nbrp.Spec.Metrics == nil || nbrp.Spec.Metrics.Port == 0 {
+		return 9090
+	}
+	return nbrp.Spec.Metrics.Port
+}
+
+// mergeContainers applies fields managed by the operator on existing containers
+// keeping fields defaulted by the API server to avoid useless patching
+func mergeContainers(existing, desired []corev1.Container) []corev1.Container {
+	merged := make([]corev1.Container, 0, len(desired))
+	for _, d := range desired {
+		c := d
+		for _, e := range existing {
+			if e.Name != d.Name {
+				continue
+			}
+			c = *e.DeepCopy()
+			c.Image = d.Image
+			c.Env = d.Env
+			c.Ports = d.Ports
+			c.SecurityContext = d.SecurityContext
+			c.Resources = d.Resources
+			c.VolumeMounts = d.VolumeMounts
+			break
 		}
-		updatedDeployment.Spec.Template.Spec.Containers[0].Resources = nbrp.Spec.Resources
-		updatedDeployment.Spec.Template.Spec.Containers[0].VolumeMounts = nbrp.Spec.VolumeMounts
+		merged = append(merged, c)
+	}
+	return merged
+}
 
-		patch := client.StrategicMergeFrom(&routingPeerDeployment)
-		bs, _ := patch.Data(updatedDeployment)
-		// To ensure no useless patching is done to the deployment being watched
-		// Minimum patch size is 2 for "{}"
-		if len(bs) <= 2 {
-			return nil
+// handleMetrics reconcile routing peer metrics Service and ServiceMonitor
+func (r *NBRoutingPeerReconciler) handleMetrics(ctx context.Context, nbrp *netbirdiov1.NBRoutingPeer, logger logr.Logger) error {
+	metricsName := types.NamespacedName{Namespace: nbrp.Namespace, Name: nbrp.Name + "-metrics"}
+
+	metricsService := corev1.Service{}
+	err := r.Client.Get(ctx, metricsName, &metricsService)
+	if err != nil && !errors.IsNotFound(err) {
+		logger.Error(errKubernetesAPI, "error getting Service", "err", err)
+		nbrp.Status.Conditions = netbirdiov1.NBConditionFalse("internalError", fmt.Sprintf("error getting Service: %v", err))
+		return err
+	}
+
+	if nbrp.Spec.Metrics == nil && errors.IsNotFound(err) {
+		// Metrics were never enabled, ServiceMonitor is only created alongside the metrics Service
+		return nil
+	}
+
+	serviceMonitor := unstructured.Unstructured{}
+	serviceMonitor.SetGroupVersionKind(serviceMonitorGVK)
+	smErr := r.Client.Get(ctx, metricsName, &serviceMonitor)
+	serviceMonitorSupported := !meta.IsNoMatchError(smErr)
+	if smErr != nil && serviceMonitorSupported && !errors.IsNotFound(smErr) {
+		logger.Error(errKubernetesAPI, "error getting ServiceMonitor", "err", smErr)
+		nbrp.Status.Conditions = netbirdiov1.NBConditionFalse("internalError", fmt.Sprintf("error getting ServiceMonitor: %v", smErr))
+		return smErr
+	}
+
+	if nbrp.Spec.Metrics == nil {
+		if err == nil {
+			logger.Info("Deleting metrics Service", "name", metricsName.Name)
+			err = r.Client.Delete(ctx, &metricsService)
+			if err != nil && !errors.IsNotFound(err) {
+				logger.Error(errKubernetesAPI, "error deleting Service", "err", err)
+				return err
+			}
 		}
-		err = r.Client.Patch(ctx, updatedDeployment, patch)
+		if smErr == nil {
+			logger.Info("Deleting ServiceMonitor", "name", metricsName.Name)
+			err = r.Client.Delete(ctx, &serviceMonitor)
+			if err != nil && !errors.IsNotFound(err) {
+				logger.Error(errKubernetesAPI, "error deleting ServiceMonitor", "err", err)
+				return err
+			}
+		}
+		return nil
+	}
+
+	ownerReferences := []v1.OwnerReference{
+		{
+			APIVersion:         netbirdiov1.GroupVersion.Identifier(),
+			Kind:               "NBRoutingPeer",
+			Name:               nbrp.Name,
+			UID:                nbrp.UID,
+			BlockOwnerDeletion: util.Ptr(true),
+		},
+	}
+	metricsLabels := map[string]string{
+		"app.kubernetes.io/name":      "netbird-router",
+		"app.kubernetes.io/instance":  nbrp.Name,
+		"app.kubernetes.io/component": "metrics",
+	}
+	for k, v := range r.DefaultLabels {
+		if _, ok := metricsLabels[k]; !ok {
+			metricsLabels[k] = v
+		}
+	}
+
+	servicePorts := []corev1.ServicePort{
+		{
+			Name:       "metrics",
+			Port:       routingPeerMetricsPort(nbrp),
+			TargetPort: intstr.FromString("metrics"),
+			Protocol:   corev1.ProtocolTCP,
+		},
+	}
+
+	if errors.IsNotFound(err) {
+		metricsService = corev1.Service{
+			ObjectMeta: v1.ObjectMeta{
+				Name:            metricsName.Name,
+				Namespace:       metricsName.Namespace,
+				OwnerReferences: ownerReferences,
+				Labels:          metricsLabels,
+			},
+			Spec: corev1.ServiceSpec{
+				Selector: routingPeerSelector(nbrp),
+				Ports:    servicePorts,
+			},
+		}
+		err = r.Client.Create(ctx, &metricsService)
 		if err != nil {
-			logger.Error(errKubernetesAPI, "error updating Deployment", "err", err)
-			nbrp.Status.Conditions = netbirdiov1.NBConditionFalse("internalError", fmt.Sprintf("error updating Deployment: %v", err))
+			logger.Error(errKubernetesAPI, "error creating Service", "err", err)
+			nbrp.Status.Conditions = netbirdiov1.NBConditionFalse("internalError", fmt.Sprintf("error creating Service: %v", err))
+			return err
+		}
+	} else if !maps.Equal(metricsService.Spec.Selector, routingPeerSelector(nbrp)) ||
+		len(metricsService.Spec.Ports) != 1 ||
+		metricsService.Spec.Ports[0].Port != servicePorts[0].Port ||
+		metricsService.Spec.Ports[0].TargetPort != servicePorts[0].TargetPort {
+		metricsService.Labels = metricsLabels
+		metricsService.Spec.Selector = routingPeerSelector(nbrp)
+		metricsService.Spec.Ports = servicePorts
+		err = r.Client.Update(ctx, &metricsService)
+		if err != nil {
+			logger.Error(errKubernetesAPI, "error updating Service", "err", err)
+			nbrp.Status.Conditions = netbirdiov1.NBConditionFalse("internalError", fmt.Sprintf("error updating Service: %v", err))
+			return err
+		}
+	}
+
+	if !serviceMonitorSupported {
+		if nbrp.Spec.Metrics.ServiceMonitor != nil {
+			logger.Info("ServiceMonitor CRD is not installed, skipping ServiceMonitor creation")
+		}
+		return nil
+	}
+
+	if nbrp.Spec.Metrics.ServiceMonitor == nil {
+		if smErr == nil {
+			logger.Info("Deleting ServiceMonitor", "name", metricsName.Name)
+			err = r.Client.Delete(ctx, &serviceMonitor)
+			if err != nil && !errors.IsNotFound(err) {
+				logger.Error(errKubernetesAPI, "error deleting ServiceMonitor", "err", err)
+				return err
+			}
+		}
+		return nil
+	}
+
+	endpoint := map[string]interface{}{
+		"port": "metrics",
+		"path": "/metrics",
+	}
+	if nbrp.Spec.Metrics.ServiceMonitor.Interval != "" {
+		endpoint["interval"] = nbrp.Spec.Metrics.ServiceMonitor.Interval
+	}
+	serviceMonitorLabels := make(map[string]interface{})
+	for k, v := range metricsLabels {
+		serviceMonitorLabels[k] = v
+	}
+	for k, v := range nbrp.Spec.Metrics.ServiceMonitor.Labels {
+		serviceMonitorLabels[k] = v
+	}
+	selectorLabels := map[string]interface{}{
+		"app.kubernetes.io/name":      "netbird-router",
+		"app.kubernetes.io/instance":  nbrp.Name,
+		"app.kubernetes.io/component": "metrics",
+	}
+	spec := map[string]interface{}{
+		"selector": map[string]interface{}{
+			"matchLabels": selectorLabels,
+		},
+		"endpoints": []interface{}{endpoint},
+	}
+
+	if errors.IsNotFound(smErr) {
+		serviceMonitor = unstructured.Unstructured{Object: map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"name":      metricsName.Name,
+				"namespace": metricsName.Namespace,
+				"labels":    serviceMonitorLabels,
+			},
+			"spec": spec,
+		}}
+		serviceMonitor.SetGroupVersionKind(serviceMonitorGVK)
+		serviceMonitor.SetOwnerReferences(ownerReferences)
+		err = r.Client.Create(ctx, &serviceMonitor)
+		if err != nil {
+			logger.Error(errKubernetesAPI, "error creating ServiceMonitor", "err", err)
+			nbrp.Status.Conditions = netbirdiov1.NBConditionFalse("internalError", fmt.Sprintf("error creating ServiceMonitor: %v", err))
+			return err
+		}
+		return nil
+	}
+
+	existingLabels, _, _ := unstructured.NestedMap(serviceMonitor.Object, "metadata", "labels")
+	existingSpec, _, _ := unstructured.NestedMap(serviceMonitor.Object, "spec")
+	if !equality.Semantic.DeepEqual(existingLabels, serviceMonitorLabels) || !equality.Semantic.DeepEqual(existingSpec, spec) {
+		serviceMonitor.Object["spec"] = spec
+		err = unstructured.SetNestedMap(serviceMonitor.Object, serviceMonitorLabels, "metadata", "labels")
+		if err != nil {
+			return err
+		}
+		err = r.Client.Update(ctx, &serviceMonitor)
+		if err != nil {
+			logger.Error(errKubernetesAPI, "error updating ServiceMonitor", "err", err)
+			nbrp.Status.Conditions = netbirdiov1.NBConditionFalse("internalError", fmt.Sprintf("error updating ServiceMonitor: %v", err))
 			return err
 		}
 	}
@@ -295,7 +1162,12 @@ func (r *NBRoutingPeerReconciler) handleDeployment(ctx context.Context, req ctrl
 }
 
 // handleRouter reconcile network routing peer in NetBird management API
-func (r *NBRoutingPeerReconciler) handleRouter(ctx context.Context, nbrp *netbirdiov1.NBRoutingPeer, nbGroup netbirdiov1.NBGroup, logger logr.Logger) error {
+func (r *NBRoutingPeerReconciler) handleRouter(ctx context.Context, req ctrl.Request, nbrp *netbirdiov1.NBRoutingPeer, nbGroup netbirdiov1.NBGroup, logger logr.Logger) error {
+	enabled, metric, err := r.routerSettings(ctx, req, nbrp, logger)
+	if err != nil {
+		return err
+	}
+
 	// Check NetworkRouter exists
 	routers, err := r.netbird.Networks.Routers(*nbrp.Status.NetworkID).List(ctx)
 
@@ -305,42 +1177,433 @@ func (r *NBRoutingPeerReconciler) handleRouter(ctx context.Context, nbrp *netbir
 		return err
 	}
 
-	if nbrp.Status.RouterID == nil || len(routers) == 0 {
-		if len(routers) > 0 {
-			// Router exists but isn't saved to status
-			nbrp.Status.RouterID = &routers[0].Id
-		} else {
-			// Create network router
-			router, err := r.netbird.Networks.Routers(*nbrp.Status.NetworkID).Create(ctx, api.NetworkRouterRequest{
-				Enabled:    true,
-				Masquerade: true,
-				Metric:     9999,
-				PeerGroups: &[]string{*nbGroup.Status.GroupID},
-			})
-
-			if err != nil {
-				logger.Error(errNetBirdAPI, "error creating network router", "err", err)
-				nbrp.Status.Conditions = netbirdiov1.NBConditionFalse("APIError", fmt.Sprintf("error creating network router: %v", err))
+	// Network may be shared with routers of other clusters, only consider the router
+	// saved to status or one owned by this cluster
+	var router *api.NetworkRouter
+	for i, rt := range routers {
+		if nbrp.Status.RouterID != nil && rt.Id == *nbrp.Status.RouterID {
+			router = &routers[i]
+			break
+		}
+		if router == nil && routerOwned(rt, *nbGroup.Status.GroupID) {
+			router = &routers[i]
+		}
+	}
+
+	if router == nil {
+		// Create network router
+		router, err := r.netbird.Networks.Routers(*nbrp.Status.NetworkID).Create(ctx, api.NetworkRouterRequest{
+			Enabled:    enabled,
+			Masquerade: true,
+			Metric:     metric,
+			PeerGroups: &[]string{*nbGroup.Status.GroupID},
+		})
+
+		if err != nil {
+			logger.Error(errNetBirdAPI, "error creating network router", "err", err)
+			nbrp.Status.Conditions = netbirdiov1.NBConditionFalse("APIError", fmt.Sprintf("error creating network router: %v", err))
+			return err
+		}
+
+		nbrp.Status.RouterID = &router.Id
+		return nil
+	}
+
+	// Router exists but may not be saved to status
+	nbrp.Status.RouterID = &router.Id
+
+	// Ensure network router settings are correct
+	if router.Enabled != enabled || !router.Masquerade || router.Metric != metric || router.PeerGroups == nil || len(*router.PeerGroups) != 1 || (*router.PeerGroups)[0] != *nbGroup.Status.GroupID {
+		_, err = r.netbird.Networks.Routers(*nbrp.Status.NetworkID).Update(ctx, router.Id, api.NetworkRouterRequest{
+			Enabled:    enabled,
+			Masquerade: true,
+			Metric:     metric,
+			PeerGroups: &[]string{*nbGroup.Status.GroupID},
+		})
+
+		if err != nil {
+			logger.Error(errNetBirdAPI, "error updating network router", "err", err)
+			nbrp.Status.Conditions = netbirdiov1.NBConditionFalse("APIError", fmt.Sprintf("error updating network router: %v", err))
+			return err
+		}
+	}
+
+	return nil
+}
+
+// routerSettings returns the desired enabled flag and metric of the network router,
+// applying spec.unhealthyAction while the routing peer workload has no available replicas
+func (r *NBRoutingPeerReconciler) routerSettings(ctx context.Context, req ctrl.Request, nbrp *netbirdiov1.NBRoutingPeer, logger logr.Logger) (bool, int, error) {
+	metric := nbrp.Spec.RouterMetric
+	if metric == 0 {
+		metric = 9999
+	}
+
+	degraded := false
+	if nbrp.Spec.UnhealthyAction != "" && nbrp.Spec.UnhealthyAction != netbirdiov1.NBRoutingPeerUnhealthyNone {
+		workload, err := r.routingPeerWorkload(ctx, req, nbrp, logger)
+		if err != nil {
+			return false, 0, err
+		}
+		// Workload not created yet is handled as healthy to avoid flapping on creation
+		degraded = workload != nil && workload.AvailableReplicas == 0
+	}
+
+	if degraded != nbrp.Status.RouterDegraded {
+		if degraded {
+			logger.Info("No routing peer pods available, applying unhealthy action", "action", nbrp.Spec.UnhealthyAction)
+			r.Recorder.Eventf(nbrp, corev1.EventTypeWarning, "RouterDegraded", "No routing peer pods available, applying unhealthy action %s to network router", nbrp.Spec.UnhealthyAction)
+		} else {
+			logger.Info("Routing peer pods available, restoring network router")
+			r.Recorder.Event(nbrp, corev1.EventTypeNormal, "RouterRestored", "Routing peer pods available, network router restored")
+		}
+		nbrp.Status.RouterDegraded = degraded
+	}
+
+	if !degraded {
+		return true, metric, nil
+	}
+
+	switch nbrp.Spec.UnhealthyAction {
+	case netbirdiov1.NBRoutingPeerUnhealthyDisable:
+		return false, metric, nil
+	case netbirdiov1.NBRoutingPeerUnhealthyRaiseMetric:
+		return true, netbirdiov1.NBRouterMaxMetric, nil
+	}
+
+	return true, metric, nil
+}
+
+// handleTemplate syncs spec of NBRoutingPeers labeled with an NBRoutingPeerTemplate from the template, keeping fields
+// set on the NBRoutingPeer itself, returns whether spec was updated
+func (r *NBRoutingPeerReconciler) handleTemplate(ctx context.Context, nbrp *netbirdiov1.NBRoutingPeer, logger logr.Logger) (bool, error) {
+	templateName := nbrp.Labels[netbirdiov1.NBRoutingPeerTemplateLabel]
+	if templateName == "" {
+		return false, nil
+	}
+
+	var template netbirdiov1.NBRoutingPeerTemplate
+	err := r.Client.Get(ctx, types.NamespacedName{Name: templateName}, &template)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			// Keep current spec until the template is created
+			logger.Info("NBRoutingPeerTemplate not found", "name", templateName)
+			return false, nil
+		}
+		logger.Error(errKubernetesAPI, "error getting NBRoutingPeerTemplate", "err", err)
+		nbrp.Status.Conditions = netbirdiov1.NBConditionFalse("internalError", fmt.Sprintf("error getting NBRoutingPeerTemplate: %v", err))
+		return false, err
+	}
+
+	var applied netbirdiov1.NBRoutingPeerSpec
+	if v, ok := nbrp.Annotations[netbirdiov1.NBRoutingPeerTemplateSpecAnnotation]; ok {
+		err = json.Unmarshal([]byte(v), &applied)
+		if err != nil {
+			// Fields previously synced from the template are kept as set on the NBRoutingPeer
+			logger.Error(errInvalidValue, "invalid template spec annotation, ignoring", "err", err)
+			applied = netbirdiov1.NBRoutingPeerSpec{}
+		}
+	}
+
+	bs, err := json.Marshal(template.Spec.Template)
+	if err != nil {
+		logger.Error(errInvalidValue, "error encoding template spec", "err", err)
+		return false, err
+	}
+
+	spec := templateSpec(nbrp.Spec, template.Spec.Template, applied)
+	if equality.Semantic.DeepEqual(nbrp.Spec, spec) && nbrp.Annotations[netbirdiov1.NBRoutingPeerTemplateSpecAnnotation] == string(bs) {
+		return false, nil
+	}
+
+	logger.Info("Updating NBRoutingPeer from template", "template", templateName)
+	nbrp.Spec = spec
+	if nbrp.Annotations == nil {
+		nbrp.Annotations = make(map[string]string)
+	}
+	nbrp.Annotations[netbirdiov1.NBRoutingPeerTemplateSpecAnnotation] = string(bs)
+	err = r.Client.Update(ctx, nbrp)
+	if err != nil {
+		logger.Error(errKubernetesAPI, "error updating NBRoutingPeer", "err", err)
+		nbrp.Status.Conditions = netbirdiov1.NBConditionFalse("internalError", fmt.Sprintf("error updating NBRoutingPeer: %v", err))
+		return false, err
+	}
+
+	return true, nil
+}
+
+// templateSpec spec with template fields merged into fields of spec that are unset, at their default value, or at
+// the value of the previously applied template. Fields set on the NBRoutingPeer itself are kept.
+func templateSpec(spec, template, applied netbirdiov1.NBRoutingPeerSpec) netbirdiov1.NBRoutingPeerSpec {
+	merged := *spec.DeepCopy()
+	template = *template.DeepCopy()
+	defaults := routingPeerSpecDefaults()
+
+	mergedValue := reflect.ValueOf(&merged).Elem()
+	for i := range mergedValue.NumField() {
+		field := mergedValue.Field(i)
+		current := field.Interface()
+		if equality.Semantic.DeepEqual(current, reflect.Zero(field.Type()).Interface()) ||
+			equality.Semantic.DeepEqual(current, reflect.ValueOf(defaults).Field(i).Interface()) ||
+			equality.Semantic.DeepEqual(current, reflect.ValueOf(applied).Field(i).Interface()) {
+			field.Set(reflect.ValueOf(template).Field(i))
+		}
+	}
+
+	// NetworkID is immutable, and not allowed in templates
+	merged.NetworkID = spec.NetworkID
+	return merged
+}
+
+// routingPeerSpecDefaults NBRoutingPeerSpec fields defaulted by the CRD
+func routingPeerSpecDefaults() netbirdiov1.NBRoutingPeerSpec {
+	return netbirdiov1.NBRoutingPeerSpec{
+		Ephemeral:       util.Ptr(true),
+		WorkloadType:    netbirdiov1.NBRoutingPeerWorkloadDeployment,
+		RouterMetric:    netbirdiov1.NBRouterMaxMetric,
+		UnhealthyAction: netbirdiov1.NBRoutingPeerUnhealthyNone,
+	}
+}
+
+// handleTopology creates/updates/deletes the per-zone NBRoutingPeers of topology routing peers
+func (r *NBRoutingPeerReconciler) handleTopology(ctx context.Context, req ctrl.Request, nbrp *netbirdiov1.NBRoutingPeer, logger logr.Logger) error {
+	// Routing peer created before enabling topology is replaced by per-zone routing peers
+	err := r.deleteRoutingPeer(ctx, req, nbrp, logger)
+	if err != nil {
+		nbrp.Status.Conditions = netbirdiov1.NBConditionFalse("internalError", fmt.Sprintf("error deleting routing peer: %v", err))
+		return err
+	}
+
+	var nbrpList netbirdiov1.NBRoutingPeerList
+	err = r.Client.List(ctx, &nbrpList, client.InNamespace(nbrp.Namespace), client.MatchingLabels{netbirdiov1.NBRoutingPeerTopologyLabel: nbrp.Name})
+	if err != nil {
+		logger.Error(errKubernetesAPI, "error listing NBRoutingPeers", "err", err)
+		nbrp.Status.Conditions = netbirdiov1.NBConditionFalse("internalError", fmt.Sprintf("error listing NBRoutingPeers: %v", err))
+		return err
+	}
+
+	zoneNBRPs := make(map[string]netbirdiov1.NBRoutingPeer)
+	for _, zoneNBRP := range nbrpList.Items {
+		zoneNBRPs[zoneNBRP.Name] = zoneNBRP
+	}
+
+	var replicas, readyReplicas int32
+	for _, zone := range nbrp.Spec.Topology.Zones {
+		name := topologyZoneName(nbrp, zone.Name)
+		spec := topologyZoneSpec(nbrp, zone)
+
+		zoneNBRP, ok := zoneNBRPs[name]
+		delete(zoneNBRPs, name)
+		if !ok {
+			zoneNBRP = netbirdiov1.NBRoutingPeer{
+				ObjectMeta: v1.ObjectMeta{
+					Name:      name,
+					Namespace: nbrp.Namespace,
+					Labels: map[string]string{
+						netbirdiov1.NBRoutingPeerTopologyLabel: nbrp.Name,
+					},
+					Finalizers: []string{"netbird.io/cleanup"},
+					OwnerReferences: []v1.OwnerReference{
+						{
+							APIVersion:         netbirdiov1.GroupVersion.Identifier(),
+							Kind:               "NBRoutingPeer",
+							Name:               nbrp.Name,
+							UID:                nbrp.UID,
+							BlockOwnerDeletion: util.Ptr(true),
+						},
+					},
+				},
+				Spec: spec,
+			}
+
+			logger.Info("Creating zone NBRoutingPeer", "name", name, "zone", zone.Name)
+			err = r.Client.Create(ctx, &zoneNBRP)
+			if err != nil {
+				logger.Error(errKubernetesAPI, "error creating NBRoutingPeer", "err", err)
+				nbrp.Status.Conditions = netbirdiov1.NBConditionFalse("internalError", fmt.Sprintf("error creating NBRoutingPeer: %v", err))
+				return err
+			}
+			continue
+		}
+
+		if !equality.Semantic.DeepEqual(zoneNBRP.Spec, spec) {
+			zoneNBRP.Spec = spec
+			logger.Info("Updating zone NBRoutingPeer", "name", name, "zone", zone.Name)
+			err = r.Client.Update(ctx, &zoneNBRP)
+			if err != nil {
+				logger.Error(errKubernetesAPI, "error updating NBRoutingPeer", "err", err)
+				nbrp.Status.Conditions = netbirdiov1.NBConditionFalse("internalError", fmt.Sprintf("error updating NBRoutingPeer: %v", err))
+				return err
+			}
+		}
+
+		replicas += zoneNBRP.Status.Replicas
+		readyReplicas += zoneNBRP.Status.ReadyReplicas
+	}
+
+	// Remaining NBRoutingPeers belong to zones removed from spec
+	for _, zoneNBRP := range zoneNBRPs {
+		if zoneNBRP.DeletionTimestamp != nil {
+			continue
+		}
+		logger.Info("Deleting zone NBRoutingPeer", "name", zoneNBRP.Name)
+		err = r.Client.Delete(ctx, &zoneNBRP)
+		if err != nil && !errors.IsNotFound(err) {
+			logger.Error(errKubernetesAPI, "error deleting NBRoutingPeer", "err", err)
+			nbrp.Status.Conditions = netbirdiov1.NBConditionFalse("internalError", fmt.Sprintf("error deleting NBRoutingPeer: %v", err))
+			return err
+		}
+	}
+
+	nbrp.Status.Replicas = replicas
+	nbrp.Status.ReadyReplicas = readyReplicas
+	nbrp.Status.Conditions = netbirdiov1.NBConditionTrue()
+
+	return nil
+}
+
+// deleteTopology deletes per-zone NBRoutingPeers, requeueing until they're gone so their
+// network routers are removed before the shared network
+func (r *NBRoutingPeerReconciler) deleteTopology(ctx context.Context, nbrp *netbirdiov1.NBRoutingPeer, logger logr.Logger) (*ctrl.Result, error) {
+	var nbrpList netbirdiov1.NBRoutingPeerList
+	err := r.Client.List(ctx, &nbrpList, client.InNamespace(nbrp.Namespace), client.MatchingLabels{netbirdiov1.NBRoutingPeerTopologyLabel: nbrp.Name})
+	if err != nil {
+		logger.Error(errKubernetesAPI, "error listing NBRoutingPeers", "err", err)
+		return &ctrl.Result{}, err
+	}
+
+	if len(nbrpList.Items) == 0 {
+		return nil, nil
+	}
+
+	for _, zoneNBRP := range nbrpList.Items {
+		if zoneNBRP.DeletionTimestamp != nil {
+			continue
+		}
+		logger.Info("Deleting zone NBRoutingPeer", "name", zoneNBRP.Name)
+		err = r.Client.Delete(ctx, &zoneNBRP)
+		if err != nil && !errors.IsNotFound(err) {
+			logger.Error(errKubernetesAPI, "error deleting NBRoutingPeer", "err", err)
+			return &ctrl.Result{}, err
+		}
+	}
+
+	return &ctrl.Result{RequeueAfter: 5 * time.Second}, nil
+}
+
+// topologyZoneName name of the NBRoutingPeer running routing peers in zone
+func topologyZoneName(nbrp *netbirdiov1.NBRoutingPeer, zone string) string {
+	return nbrp.Name + "-" + strings.Trim(zoneNameRegexp.ReplaceAllString(strings.ToLower(zone), "-"), "-")
+}
+
+// topologyZoneSpec spec of the NBRoutingPeer running routing peers in zone, inheriting the topology NBRoutingPeer spec.
+// Network level settings are kept by the topology NBRoutingPeer.
+func topologyZoneSpec(nbrp *netbirdiov1.NBRoutingPeer, zone netbirdiov1.NBRoutingPeerZone) netbirdiov1.NBRoutingPeerSpec {
+	spec := *nbrp.Spec.DeepCopy()
+	spec.Topology = nil
+	spec.ClusterCIDRs = nil
+	spec.NetworkName = ""
+	spec.NetworkDescription = nil
+	spec.NetworkID = *nbrp.Status.NetworkID
+	spec.RouterMetric = zone.Metric
+
+	topologyKey := nbrp.Spec.Topology.TopologyKey
+	if topologyKey == "" {
+		topologyKey = corev1.LabelTopologyZone
+	}
+	if spec.NodeSelector == nil {
+		spec.NodeSelector = make(map[string]string)
+	}
+	spec.NodeSelector[topologyKey] = zone.Name
+
+	if spec.Labels == nil {
+		spec.Labels = make(map[string]string)
+	}
+	spec.Labels[netbirdiov1.NBRoutingPeerTopologyLabel] = nbrp.Name
+
+	return spec
+}
+
+// handleExitNode creates/updates/deletes the NBRoute routing internet traffic through routing peers
+func (r *NBRoutingPeerReconciler) handleExitNode(ctx context.Context, nbrp *netbirdiov1.NBRoutingPeer, nbGroup netbirdiov1.NBGroup, logger logr.Logger) error {
+	nbRoute := netbirdiov1.NBRoute{}
+	err := r.Client.Get(ctx, types.NamespacedName{Namespace: nbrp.Namespace, Name: nbrp.Name + "-exit-node"}, &nbRoute)
+	if err != nil && !errors.IsNotFound(err) {
+		logger.Error(errKubernetesAPI, "error getting NBRoute", "err", err)
+		nbrp.Status.Conditions = netbirdiov1.NBConditionFalse("internalError", fmt.Sprintf("error getting NBRoute: %v", err))
+		return err
+	}
+
+	if nbrp.Spec.ExitNode == nil {
+		if err == nil && nbRoute.DeletionTimestamp == nil {
+			logger.Info("Deleting exit node NBRoute", "name", nbRoute.Name)
+			err = r.Client.Delete(ctx, &nbRoute)
+			if err != nil && !errors.IsNotFound(err) {
+				logger.Error(errKubernetesAPI, "error deleting NBRoute", "err", err)
 				return err
 			}
+		}
+		return nil
+	}
+
+	networkIdentifier := nbGroup.Spec.Name + "-exit-node"
+	if len(networkIdentifier) > 40 {
+		networkIdentifier = networkIdentifier[:40]
+	}
+
+	spec := netbirdiov1.NBRouteSpec{
+		NetworkIdentifier: networkIdentifier,
+		Description:       fmt.Sprintf("Exit node of kubernetes-operator (cluster: %s)", r.ClusterName),
+		Network:           "0.0.0.0/0",
+		PeerGroups:        []string{nbGroup.Spec.Name},
+		Groups:            nbrp.Spec.ExitNode.Groups,
+		Metric:            nbrp.Spec.ExitNode.Metric,
+		Masquerade:        nbrp.Spec.ExitNode.Masquerade,
+		Enabled:           util.Ptr(true),
+	}
+	if spec.Metric == 0 {
+		spec.Metric = 9999
+	}
+	if spec.Masquerade == nil {
+		spec.Masquerade = util.Ptr(true)
+	}
 
-			nbrp.Status.RouterID = &router.Id
+	if errors.IsNotFound(err) {
+		nbRoute = netbirdiov1.NBRoute{
+			ObjectMeta: v1.ObjectMeta{
+				Name:      nbrp.Name + "-exit-node",
+				Namespace: nbrp.Namespace,
+				Labels:    r.DefaultLabels,
+				OwnerReferences: []v1.OwnerReference{
+					{
+						APIVersion:         netbirdiov1.GroupVersion.Identifier(),
+						Kind:               "NBRoutingPeer",
+						Name:               nbrp.Name,
+						UID:                nbrp.UID,
+						BlockOwnerDeletion: util.Ptr(true),
+					},
+				},
+			},
+			Spec: spec,
 		}
-	} else {
-		// Ensure network router settings are correct
-		if !routers[0].Enabled || !routers[0].Masquerade || routers[0].Metric != 9999 || len(*routers[0].PeerGroups) != 1 || (*routers[0].PeerGroups)[0] != *nbGroup.Status.GroupID {
-			_, err = r.netbird.Networks.Routers(*nbrp.Status.NetworkID).Update(ctx, routers[0].Id, api.NetworkRouterRequest{
-				Enabled:    true,
-				Masquerade: true,
-				Metric:     9999,
-				PeerGroups: &[]string{*nbGroup.Status.GroupID},
-			})
 
-			if err != nil {
-				logger.Error(errNetBirdAPI, "error updating network router", "err", err)
-				nbrp.Status.Conditions = netbirdiov1.NBConditionFalse("APIError", fmt.Sprintf("error updating network router: %v", err))
-				return err
-			}
+		logger.Info("Creating exit node NBRoute", "name", nbRoute.Name)
+		err = r.Client.Create(ctx, &nbRoute)
+		if err != nil {
+			logger.Error(errKubernetesAPI, "error creating NBRoute", "err", err)
+			nbrp.Status.Conditions = netbirdiov1.NBConditionFalse("internalError", fmt.Sprintf("error creating NBRoute: %v", err))
+			return err
+		}
+		return nil
+	}
+
+	if !equality.Semantic.DeepEqual(nbRoute.Spec, spec) {
+		nbRoute.Spec = spec
+		err = r.Client.Update(ctx, &nbRoute)
+		if err != nil {
+			logger.Error(errKubernetesAPI, "error updating NBRoute", "err", err)
+			nbrp.Status.Conditions = netbirdiov1.NBConditionFalse("internalError", fmt.Sprintf("error updating NBRoute: %v", err))
+			return err
 		}
 	}
 
@@ -349,17 +1612,14 @@ func (r *NBRoutingPeerReconciler) handleRouter(ctx context.Context, nbrp *netbir
 
 // handleSetupKey reconcile setup key and regenerate if invalid
 func (r *NBRoutingPeerReconciler) handleSetupKey(ctx context.Context, req ctrl.Request, nbrp *netbirdiov1.NBRoutingPeer, nbGroup netbirdiov1.NBGroup, logger logr.Logger) (*ctrl.Result, error) {
-	networkName := r.ClusterName
-	if r.NamespacedNetworks {
-		networkName += "-" + req.Namespace
-	}
+	networkName := r.defaultNetworkName(nbrp)
 
 	// Check if setup key exists
 	if nbrp.Status.SetupKeyID == nil {
 		// Create new setup key with group Status.GroupID
 		setupKey, err := r.netbird.SetupKeys.Create(ctx, api.CreateSetupKeyRequest{
 			AutoGroups: []string{*nbGroup.Status.GroupID},
-			Ephemeral:  util.Ptr(true),
+			Ephemeral:  util.Ptr(routingPeerEphemeral(nbrp)),
 			Name:       networkName,
 			Type:       "reusable",
 		})
@@ -410,8 +1670,9 @@ func (r *NBRoutingPeerReconciler) handleSetupKey(ctx context.Context, req ctrl.R
 			return &ctrl.Result{}, err
 		}
 
-		if err != nil || setupKey.Revoked {
-			if setupKey != nil && setupKey.Revoked {
+		// Regenerate setup key if revoked or ephemeral setting changed
+		if err != nil || setupKey.Revoked || setupKey.Ephemeral != routingPeerEphemeral(nbrp) {
+			if setupKey != nil {
 				err = r.netbird.SetupKeys.Delete(ctx, *nbrp.Status.SetupKeyID)
 
 				if err != nil {
@@ -457,12 +1718,14 @@ func (r *NBRoutingPeerReconciler) handleSetupKey(ctx context.Context, req ctrl.R
 	return nil, nil
 }
 
+// routingPeerEphemeral returns whether routing peers should register as ephemeral peers, defaults to true
+func routingPeerEphemeral(nbrp *netbirdiov1.NBRoutingPeer) bool {
+	return nbrp.Spec.Ephemeral == nil || *nbrp.Spec.Ephemeral
+}
+
 // handleGroup creates/updates NBGroup for routing peer
 func (r *NBRoutingPeerReconciler) handleGroup(ctx context.Context, req ctrl.Request, nbrp *netbirdiov1.NBRoutingPeer, logger logr.Logger) (*netbirdiov1.NBGroup, *ctrl.Result, error) {
-	networkName := r.ClusterName
-	if r.NamespacedNetworks {
-		networkName += "-" + req.Namespace
-	}
+	networkName := r.defaultNetworkName(nbrp)
 
 	// Check if NetBird Group exists
 	nbGroup := netbirdiov1.NBGroup{}
@@ -517,9 +1780,18 @@ func (r *NBRoutingPeerReconciler) handleGroup(ctx context.Context, req ctrl.Requ
 
 // handleNetwork Create/Update NetBird Network
 func (r *NBRoutingPeerReconciler) handleNetwork(ctx context.Context, req ctrl.Request, nbrp *netbirdiov1.NBRoutingPeer, logger logr.Logger) error {
-	networkName := r.ClusterName
-	if r.NamespacedNetworks {
-		networkName += "-" + req.Namespace
+	if nbrp.Spec.NetworkID != "" {
+		return r.adoptNetwork(ctx, nbrp, logger)
+	}
+
+	networkName := r.defaultNetworkName(nbrp)
+	if nbrp.Spec.NetworkName != "" {
+		networkName = nbrp.Spec.NetworkName
+	}
+
+	description := networkDescription
+	if nbrp.Spec.NetworkDescription != nil {
+		description = *nbrp.Spec.NetworkDescription
 	}
 
 	if nbrp.Status.NetworkID == nil {
@@ -540,11 +1812,12 @@ func (r *NBRoutingPeerReconciler) handleNetwork(ctx context.Context, req ctrl.Re
 
 		if network != nil {
 			nbrp.Status.NetworkID = &network.Id
+			nbrp.Status.NetworkAdopted = true
 		} else {
 			logger.Info("creating network", "name", networkName)
 			network, err := r.netbird.Networks.Create(ctx, api.NetworkRequest{
 				Name:        networkName,
-				Description: &networkDescription,
+				Description: &description,
 			})
 			if err != nil {
 				logger.Error(errNetBirdAPI, "error creating network", "err", err)
@@ -553,23 +1826,67 @@ func (r *NBRoutingPeerReconciler) handleNetwork(ctx context.Context, req ctrl.Re
 			}
 
 			nbrp.Status.NetworkID = &network.Id
+			nbrp.Status.NetworkAdopted = false
+		}
+		return nil
+	}
+
+	if nbrp.Status.NetworkAdopted {
+		// Adopted networks are managed outside the operator
+		return nil
+	}
+
+	// Ensure created network name and description are up-to-date
+	network, err := r.netbird.Networks.Get(ctx, *nbrp.Status.NetworkID)
+	if err != nil {
+		logger.Error(errNetBirdAPI, "error getting network", "err", err)
+		nbrp.Status.Conditions = netbirdiov1.NBConditionFalse("APIError", fmt.Sprintf("error getting network: %v", err))
+		return err
+	}
+
+	if network.Name != networkName || network.Description == nil || *network.Description != description {
+		logger.Info("updating network", "network-id", network.Id, "name", networkName)
+		_, err = r.netbird.Networks.Update(ctx, network.Id, api.NetworkRequest{
+			Name:        networkName,
+			Description: &description,
+		})
+		if err != nil {
+			logger.Error(errNetBirdAPI, "error updating network", "err", err)
+			nbrp.Status.Conditions = netbirdiov1.NBConditionFalse("APIError", fmt.Sprintf("error updating network: %v", err))
+			return err
 		}
 	}
+
 	return nil
 }
 
-func (r *NBRoutingPeerReconciler) handleDelete(ctx context.Context, req ctrl.Request, nbrp *netbirdiov1.NBRoutingPeer, logger logr.Logger) (ctrl.Result, error) {
+// deleteRoutingPeer deletes the routing peer workload, setup key, exit node and network router
+func (r *NBRoutingPeerReconciler) deleteRoutingPeer(ctx context.Context, req ctrl.Request, nbrp *netbirdiov1.NBRoutingPeer, logger logr.Logger) error {
 	nbDeployment := appsv1.Deployment{}
 	err := r.Client.Get(ctx, req.NamespacedName, &nbDeployment)
 	if err != nil && !errors.IsNotFound(err) {
 		logger.Error(errKubernetesAPI, "error getting Deployment", "err", err)
-		return ctrl.Result{}, err
+		return err
 	}
 	if err == nil {
 		err = r.Client.Delete(ctx, &nbDeployment)
 		if err != nil {
 			logger.Error(errKubernetesAPI, "error deleting Deployment", "err", err)
-			return ctrl.Result{}, err
+			return err
+		}
+	}
+
+	nbStatefulSet := appsv1.StatefulSet{}
+	err = r.Client.Get(ctx, req.NamespacedName, &nbStatefulSet)
+	if err != nil && !errors.IsNotFound(err) {
+		logger.Error(errKubernetesAPI, "error getting StatefulSet", "err", err)
+		return err
+	}
+	if err == nil {
+		err = r.Client.Delete(ctx, &nbStatefulSet)
+		if err != nil {
+			logger.Error(errKubernetesAPI, "error deleting StatefulSet", "err", err)
+			return err
 		}
 	}
 
@@ -578,7 +1895,7 @@ func (r *NBRoutingPeerReconciler) handleDelete(ctx context.Context, req ctrl.Req
 		err = r.netbird.SetupKeys.Delete(ctx, *nbrp.Status.SetupKeyID)
 		if err != nil && !strings.Contains(err.Error(), "not found") {
 			logger.Error(errNetBirdAPI, "error deleting setupKey", "err", err)
-			return ctrl.Result{}, err
+			return err
 		}
 
 		setupKeyID := *nbrp.Status.SetupKeyID
@@ -586,16 +1903,110 @@ func (r *NBRoutingPeerReconciler) handleDelete(ctx context.Context, req ctrl.Req
 		logger.Info("Setup key deleted", "id", setupKeyID)
 	}
 
+	exitNodeRoute := netbirdiov1.NBRoute{}
+	err = r.Client.Get(ctx, types.NamespacedName{Namespace: nbrp.Namespace, Name: nbrp.Name + "-exit-node"}, &exitNodeRoute)
+	if err != nil && !errors.IsNotFound(err) {
+		logger.Error(errKubernetesAPI, "error getting NBRoute", "err", err)
+		return err
+	}
+	if err == nil && exitNodeRoute.DeletionTimestamp == nil {
+		logger.Info("Deleting exit node NBRoute", "name", exitNodeRoute.Name)
+		err = r.Client.Delete(ctx, &exitNodeRoute)
+		if err != nil && !errors.IsNotFound(err) {
+			logger.Error(errKubernetesAPI, "error deleting NBRoute", "err", err)
+			return err
+		}
+	}
+
 	if nbrp.Status.RouterID != nil {
-		err = r.netbird.Networks.Routers(*nbrp.Status.NetworkID).Delete(ctx, *nbrp.Status.RouterID)
-		if err != nil && !strings.Contains(err.Error(), "not found") {
-			logger.Error(errNetBirdAPI, "error deleting Network Router", "err", err)
-			return ctrl.Result{}, err
+		owned, err := r.routerOwned(ctx, req, nbrp, logger)
+		if err != nil {
+			return err
+		}
+
+		if owned {
+			err = r.netbird.Networks.Routers(*nbrp.Status.NetworkID).Delete(ctx, *nbrp.Status.RouterID)
+			if err != nil && !strings.Contains(err.Error(), "not found") {
+				logger.Error(errNetBirdAPI, "error deleting Network Router", "err", err)
+				return err
+			}
+		} else {
+			logger.Info("Leaving Network Router not owned by this cluster", "id", *nbrp.Status.RouterID)
 		}
 
 		nbrp.Status.RouterID = nil
 	}
 
+	return nil
+}
+
+// routerOwned whether network router routes through groupID. Routing peer groups are named after
+// the cluster, tagging routers with the cluster owning them in networks shared between clusters.
+func routerOwned(router api.NetworkRouter, groupID string) bool {
+	return router.PeerGroups != nil && util.Contains(*router.PeerGroups, groupID)
+}
+
+// routerOwned whether network router saved to status still routes through the routing peer group of this cluster,
+// routers gone already are reported as owned
+func (r *NBRoutingPeerReconciler) routerOwned(ctx context.Context, req ctrl.Request, nbrp *netbirdiov1.NBRoutingPeer, logger logr.Logger) (bool, error) {
+	router, err := r.netbird.Networks.Routers(*nbrp.Status.NetworkID).Get(ctx, *nbrp.Status.RouterID)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return true, nil
+		}
+		logger.Error(errNetBirdAPI, "error getting Network Router", "err", err)
+		return false, err
+	}
+
+	nbGroup := netbirdiov1.NBGroup{}
+	err = r.Client.Get(ctx, req.NamespacedName, &nbGroup)
+	if err != nil && !errors.IsNotFound(err) {
+		logger.Error(errKubernetesAPI, "error getting NBGroup", "err", err)
+		return false, err
+	}
+	if nbGroup.Status.GroupID == nil {
+		return false, nil
+	}
+
+	return routerOwned(*router, *nbGroup.Status.GroupID), nil
+}
+
+// adoptNetwork ensures NetBird Network referenced by spec.networkID exists and saves it to status
+func (r *NBRoutingPeerReconciler) adoptNetwork(ctx context.Context, nbrp *netbirdiov1.NBRoutingPeer, logger logr.Logger) error {
+	if nbrp.Status.NetworkID != nil && *nbrp.Status.NetworkID == nbrp.Spec.NetworkID {
+		nbrp.Status.NetworkAdopted = true
+		return nil
+	}
+
+	network, err := r.netbird.Networks.Get(ctx, nbrp.Spec.NetworkID)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			logger.Error(errInvalidValue, "network not found", "network-id", nbrp.Spec.NetworkID)
+			nbrp.Status.Conditions = netbirdiov1.NBConditionFalse("NetworkNotFound", fmt.Sprintf("network %s not found", nbrp.Spec.NetworkID))
+			return err
+		}
+		logger.Error(errNetBirdAPI, "error getting network", "err", err)
+		nbrp.Status.Conditions = netbirdiov1.NBConditionFalse("APIError", fmt.Sprintf("error getting network: %v", err))
+		return err
+	}
+
+	logger.Info("adopting network", "network-id", network.Id, "name", network.Name)
+	nbrp.Status.NetworkID = &network.Id
+	nbrp.Status.NetworkAdopted = true
+	return nil
+}
+
+func (r *NBRoutingPeerReconciler) handleDelete(ctx context.Context, req ctrl.Request, nbrp *netbirdiov1.NBRoutingPeer, logger logr.Logger) (ctrl.Result, error) {
+	result, err := r.deleteTopology(ctx, nbrp, logger)
+	if result != nil {
+		return *result, err
+	}
+
+	err = r.deleteRoutingPeer(ctx, req, nbrp, logger)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
 	nbGroup := netbirdiov1.NBGroup{}
 	err = r.Client.Get(ctx, req.NamespacedName, &nbGroup)
 	if err != nil && !errors.IsNotFound(err) {
@@ -603,6 +2014,11 @@ func (r *NBRoutingPeerReconciler) handleDelete(ctx context.Context, req ctrl.Req
 		return ctrl.Result{}, err
 	}
 
+	if nbrp.Labels[netbirdiov1.NBRoutingPeerTopologyLabel] != "" {
+		// Network and its resources are managed by the topology NBRoutingPeer
+		nbrp.Status.NetworkID = nil
+	}
+
 	if nbrp.Status.NetworkID != nil {
 		nbResourceList := netbirdiov1.NBResourceList{}
 		err = r.Client.List(ctx, &nbResourceList)
@@ -611,23 +2027,44 @@ func (r *NBRoutingPeerReconciler) handleDelete(ctx context.Context, req ctrl.Req
 			return ctrl.Result{}, err
 		}
 
+		networkResources := 0
 		for _, nbrs := range nbResourceList.Items {
-			if nbrs.Spec.NetworkID == *nbrp.Status.NetworkID {
-				logger.Info("Deleting NBResource", "namespace", nbrs.Namespace, "name", nbrs.Name)
-				err = r.Client.Delete(ctx, &nbrs)
+			if nbrs.ResolvedNetworkID() != *nbrp.Status.NetworkID {
+				continue
+			}
+			networkResources++
+			if nbrs.DeletionTimestamp != nil {
+				continue
+			}
+			logger.Info("Deleting NBResource", "namespace", nbrs.Namespace, "name", nbrs.Name)
+			err = r.Client.Delete(ctx, &nbrs)
+			if err != nil && !errors.IsNotFound(err) {
+				logger.Error(errKubernetesAPI, "error deleting NBResource", "err", err)
+				return ctrl.Result{}, err
+			}
+		}
+
+		if networkResources == 0 {
+			if nbrp.Status.NetworkAdopted {
+				// Network wasn't created by the operator, leave it in place
+				logger.Info("Leaving adopted NetBird Network", "id", *nbrp.Status.NetworkID)
+			} else {
+				inUse, err := r.networkInUse(ctx, *nbrp.Status.NetworkID, logger)
 				if err != nil {
-					logger.Error(errKubernetesAPI, "error deleting NBResource", "err", err)
 					return ctrl.Result{}, err
 				}
-			}
-		}
 
-		if len(nbResourceList.Items) == 0 {
-			logger.Info("Deleting NetBird Network", "id", *nbrp.Status.NetworkID)
-			err = r.netbird.Networks.Delete(ctx, *nbrp.Status.NetworkID)
-			if err != nil && !strings.Contains(err.Error(), "not found") {
-				logger.Error(errNetBirdAPI, "error deleting Network", "err", err)
-				return ctrl.Result{}, err
+				if inUse {
+					// Other clusters still contribute routers or resources to this network
+					logger.Info("Leaving NetBird Network used by other clusters", "id", *nbrp.Status.NetworkID)
+				} else {
+					logger.Info("Deleting NetBird Network", "id", *nbrp.Status.NetworkID)
+					err = r.netbird.Networks.Delete(ctx, *nbrp.Status.NetworkID)
+					if err != nil && !strings.Contains(err.Error(), "not found") {
+						logger.Error(errNetBirdAPI, "error deleting Network", "err", err)
+						return ctrl.Result{}, err
+					}
+				}
 			}
 
 			nbrp.Status.NetworkID = nil
@@ -661,6 +2098,221 @@ func (r *NBRoutingPeerReconciler) handleDelete(ctx context.Context, req ctrl.Req
 	return ctrl.Result{}, nil
 }
 
+// networkInUse checks if NetBird Network still has routers or resources, possibly registered by other clusters
+func (r *NBRoutingPeerReconciler) networkInUse(ctx context.Context, networkID string, logger logr.Logger) (bool, error) {
+	network, err := r.netbird.Networks.Get(ctx, networkID)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return false, nil
+		}
+		logger.Error(errNetBirdAPI, "error getting Network", "err", err)
+		return false, err
+	}
+
+	return len(network.Routers) > 0 || len(network.Resources) > 0, nil
+}
+
+// handleClusterCIDRs creates/updates/deletes NBResources exposing cluster IP ranges
+func (r *NBRoutingPeerReconciler) handleClusterCIDRs(ctx context.Context, nbrp *netbirdiov1.NBRoutingPeer, logger logr.Logger) error {
+	nbResourceList := netbirdiov1.NBResourceList{}
+	err := r.Client.List(ctx, &nbResourceList, client.InNamespace(nbrp.Namespace), client.MatchingLabels{clusterCIDRLabel: nbrp.Name})
+	if err != nil {
+		logger.Error(errKubernetesAPI, "error listing NBResource", "err", err)
+		nbrp.Status.Conditions = netbirdiov1.NBConditionFalse("internalError", fmt.Sprintf("error listing NBResource: %v", err))
+		return err
+	}
+
+	desired := make(map[string]string)
+	if nbrp.Spec.ClusterCIDRs != nil {
+		desired, err = r.clusterCIDRs(ctx, nbrp, logger)
+		if err != nil {
+			return err
+		}
+	}
+
+	existing := make(map[string]netbirdiov1.NBResource)
+	for _, nbrs := range nbResourceList.Items {
+		if _, ok := desired[nbrs.Name]; ok {
+			existing[nbrs.Name] = nbrs
+			continue
+		}
+		logger.Info("Deleting cluster CIDR NBResource", "name", nbrs.Name, "address", nbrs.Spec.Address)
+		err = r.Client.Delete(ctx, &nbrs)
+		if err != nil && !errors.IsNotFound(err) {
+			logger.Error(errKubernetesAPI, "error deleting NBResource", "err", err)
+			return err
+		}
+	}
+
+	labels := map[string]string{clusterCIDRLabel: nbrp.Name}
+	for k, v := range r.DefaultLabels {
+		if _, ok := labels[k]; !ok {
+			labels[k] = v
+		}
+	}
+
+	var exposed []string
+	for name, cidr := range desired {
+		exposed = append(exposed, cidr)
+		spec := netbirdiov1.NBResourceSpec{
+			Name:      fmt.Sprintf("%s-%s", r.ClusterName, name),
+			NetworkID: *nbrp.Status.NetworkID,
+			Address:   cidr,
+			Groups:    nbrp.Spec.ClusterCIDRs.Groups,
+		}
+
+		nbrs, ok := existing[name]
+		if !ok {
+			nbrs = netbirdiov1.NBResource{
+				ObjectMeta: v1.ObjectMeta{
+					Name:       name,
+					Namespace:  nbrp.Namespace,
+					Labels:     labels,
+					Finalizers: []string{"netbird.io/cleanup"},
+					OwnerReferences: []v1.OwnerReference{
+						{
+							APIVersion:         netbirdiov1.GroupVersion.Identifier(),
+							Kind:               "NBRoutingPeer",
+							Name:               nbrp.Name,
+							UID:                nbrp.UID,
+							BlockOwnerDeletion: util.Ptr(true),
+						},
+					},
+				},
+				Spec: spec,
+			}
+			logger.Info("Creating cluster CIDR NBResource", "name", name, "address", cidr)
+			err = r.Client.Create(ctx, &nbrs)
+			if err != nil {
+				logger.Error(errKubernetesAPI, "error creating NBResource", "err", err)
+				nbrp.Status.Conditions = netbirdiov1.NBConditionFalse("internalError", fmt.Sprintf("error creating NBResource: %v", err))
+				return err
+			}
+			continue
+		}
+
+		// NetworkID is immutable, resources of a previous network are recreated on the next reconciliation
+		if nbrs.Spec.NetworkID != spec.NetworkID {
+			err = r.Client.Delete(ctx, &nbrs)
+			if err != nil && !errors.IsNotFound(err) {
+				logger.Error(errKubernetesAPI, "error deleting NBResource", "err", err)
+				return err
+			}
+			continue
+		}
+
+		if !nbrs.Spec.Equal(spec) {
+			nbrs.Spec = spec
+			err = r.Client.Update(ctx, &nbrs)
+			if err != nil {
+				logger.Error(errKubernetesAPI, "error updating NBResource", "err", err)
+				nbrp.Status.Conditions = netbirdiov1.NBConditionFalse("internalError", fmt.Sprintf("error updating NBResource: %v", err))
+				return err
+			}
+		}
+	}
+
+	slices.Sort(exposed)
+	nbrp.Status.ClusterCIDRs = exposed
+
+	return nil
+}
+
+// clusterCIDRs discovers cluster IP ranges to expose, keyed by NBResource name
+func (r *NBRoutingPeerReconciler) clusterCIDRs(ctx context.Context, nbrp *netbirdiov1.NBRoutingPeer, logger logr.Logger) (map[string]string, error) {
+	cidrs := make(map[string]string)
+	add := func(kind, cidr string) {
+		cidrs[fmt.Sprintf("%s-%s-%s", nbrp.Name, kind, strings.NewReplacer(".", "-", ":", "-", "/", "-").Replace(cidr))] = cidr
+	}
+
+	if nbrp.Spec.ClusterCIDRs.Pods || nbrp.Spec.ClusterCIDRs.Nodes {
+		nodeList := corev1.NodeList{}
+		err := r.Client.List(ctx, &nodeList)
+		if err != nil {
+			logger.Error(errKubernetesAPI, "error listing Nodes", "err", err)
+			nbrp.Status.Conditions = netbirdiov1.NBConditionFalse("internalError", fmt.Sprintf("error listing Nodes: %v", err))
+			return nil, err
+		}
+
+		for _, node := range nodeList.Items {
+			if nbrp.Spec.ClusterCIDRs.Pods {
+				podCIDRs := node.Spec.PodCIDRs
+				if len(podCIDRs) == 0 && node.Spec.PodCIDR != "" {
+					podCIDRs = []string{node.Spec.PodCIDR}
+				}
+				for _, cidr := range podCIDRs {
+					add("pods", cidr)
+				}
+			}
+			if nbrp.Spec.ClusterCIDRs.Nodes {
+				for _, addr := range node.Status.Addresses {
+					ip := net.ParseIP(addr.Address)
+					if addr.Type != corev1.NodeInternalIP || ip == nil {
+						continue
+					}
+					if ip.To4() != nil {
+						add("node", ip.String()+"/32")
+					} else {
+						add("node", ip.String()+"/128")
+					}
+				}
+			}
+		}
+	}
+
+	if nbrp.Spec.ClusterCIDRs.Services {
+		serviceCIDRs := nbrp.Spec.ClusterCIDRs.ServiceCIDRs
+		if len(serviceCIDRs) == 0 {
+			var err error
+			serviceCIDRs, err = r.serviceCIDRs(ctx)
+			if err != nil {
+				logger.Error(errKubernetesAPI, "error listing ServiceCIDRs", "err", err)
+				nbrp.Status.Conditions = netbirdiov1.NBConditionFalse("internalError", fmt.Sprintf("error listing ServiceCIDRs: %v", err))
+				return nil, err
+			}
+		}
+		if len(serviceCIDRs) == 0 {
+			err := fmt.Errorf("no ServiceCIDR objects found")
+			logger.Error(errInvalidValue, "service CIDR unknown, set clusterCIDRs.serviceCIDRs explicitly", "err", err)
+			nbrp.Status.Conditions = netbirdiov1.NBConditionFalse("ServiceCIDRUnknown", "service CIDR can't be discovered without ServiceCIDR objects (Kubernetes 1.31+), set clusterCIDRs.serviceCIDRs explicitly")
+			return nil, err
+		}
+		for _, cidr := range serviceCIDRs {
+			add("services", cidr)
+		}
+	}
+
+	return cidrs, nil
+}
+
+// serviceCIDRs service CIDRs from ServiceCIDR objects, served by networking.k8s.io/v1 or v1beta1 on Kubernetes 1.31+.
+// Returns no CIDRs if neither API version is served.
+func (r *NBRoutingPeerReconciler) serviceCIDRs(ctx context.Context) ([]string, error) {
+	for _, gvk := range serviceCIDRGVKs {
+		var serviceCIDRList unstructured.UnstructuredList
+		serviceCIDRList.SetGroupVersionKind(gvk)
+		err := r.Client.List(ctx, &serviceCIDRList)
+		if meta.IsNoMatchError(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var cidrs []string
+		for _, serviceCIDR := range serviceCIDRList.Items {
+			if serviceCIDR.GetDeletionTimestamp() != nil {
+				continue
+			}
+			v, _, _ := unstructured.NestedStringSlice(serviceCIDR.Object, "spec", "cidrs")
+			cidrs = append(cidrs, v...)
+		}
+		return cidrs, nil
+	}
+
+	return nil, nil
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *NBRoutingPeerReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	r.netbird = netbird.New(r.ManagementURL, r.APIKey)
@@ -669,7 +2321,82 @@ func (r *NBRoutingPeerReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		For(&netbirdiov1.NBRoutingPeer{}).
 		Named("nbroutingpeer").
 		Watches(&appsv1.Deployment{}, handler.EnqueueRequestForOwner(r.Scheme, mgr.GetRESTMapper(), &netbirdiov1.NBRoutingPeer{})).
+		Watches(&appsv1.StatefulSet{}, handler.EnqueueRequestForOwner(r.Scheme, mgr.GetRESTMapper(), &netbirdiov1.NBRoutingPeer{})).
 		Watches(&corev1.Secret{}, handler.EnqueueRequestForOwner(r.Scheme, mgr.GetRESTMapper(), &netbirdiov1.NBRoutingPeer{})).
+		Watches(&corev1.Service{}, handler.EnqueueRequestForOwner(r.Scheme, mgr.GetRESTMapper(), &netbirdiov1.NBRoutingPeer{})).
 		Watches(&netbirdiov1.NBGroup{}, handler.EnqueueRequestForOwner(r.Scheme, mgr.GetRESTMapper(), &netbirdiov1.NBRoutingPeer{})).
+		Watches(&netbirdiov1.NBRoute{}, handler.EnqueueRequestForOwner(r.Scheme, mgr.GetRESTMapper(), &netbirdiov1.NBRoutingPeer{})).
+		Watches(&netbirdiov1.NBResource{}, handler.EnqueueRequestForOwner(r.Scheme, mgr.GetRESTMapper(), &netbirdiov1.NBRoutingPeer{})).
+		Watches(&netbirdiov1.NBRoutingPeer{}, handler.EnqueueRequestForOwner(r.Scheme, mgr.GetRESTMapper(), &netbirdiov1.NBRoutingPeer{})).
+		Watches(&corev1.Node{}, handler.EnqueueRequestsFromMapFunc(r.clusterCIDRRoutingPeers), builder.WithPredicates(nodeCIDRsChanged)).
+		Watches(&netbirdiov1.NBRoutingPeerTemplate{}, handler.EnqueueRequestsFromMapFunc(r.templateRoutingPeers)).
 		Complete(r)
 }
+
+// nodeCIDRsChanged filters Node events to those changing exposed cluster CIDRs, ignoring status heartbeats
+var nodeCIDRsChanged = predicate.Funcs{
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		oldNode, ok := e.ObjectOld.(*corev1.Node)
+		if !ok {
+			return true
+		}
+		newNode, ok := e.ObjectNew.(*corev1.Node)
+		if !ok {
+			return true
+		}
+		return oldNode.Spec.PodCIDR != newNode.Spec.PodCIDR ||
+			!slices.Equal(oldNode.Spec.PodCIDRs, newNode.Spec.PodCIDRs) ||
+			!slices.Equal(nodeInternalIPs(*oldNode), nodeInternalIPs(*newNode))
+	},
+	GenericFunc: func(e event.GenericEvent) bool {
+		return false
+	},
+}
+
+// nodeInternalIPs internal IP addresses of Node
+func nodeInternalIPs(node corev1.Node) []string {
+	var ips []string
+	for _, addr := range node.Status.Addresses {
+		if addr.Type == corev1.NodeInternalIP {
+			ips = append(ips, addr.Address)
+		}
+	}
+	return ips
+}
+
+// clusterCIDRRoutingPeers maps Node events to NBRoutingPeers exposing cluster CIDRs
+func (r *NBRoutingPeerReconciler) clusterCIDRRoutingPeers(ctx context.Context, _ client.Object) []reconcile.Request {
+	nbrpList := netbirdiov1.NBRoutingPeerList{}
+	err := r.Client.List(ctx, &nbrpList)
+	if err != nil {
+		ctrl.Log.WithName("NBRoutingPeer").Error(errKubernetesAPI, "error listing NBRoutingPeer", "err", err)
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, nbrp := range nbrpList.Items {
+		if nbrp.Spec.ClusterCIDRs == nil {
+			continue
+		}
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: nbrp.Namespace, Name: nbrp.Name}})
+	}
+
+	return requests
+}
+
+// templateRoutingPeers maps NBRoutingPeerTemplate events to NBRoutingPeers created from the template
+func (r *NBRoutingPeerReconciler) templateRoutingPeers(ctx context.Context, obj client.Object) []reconcile.Request {
+	nbrpList := netbirdiov1.NBRoutingPeerList{}
+	err := r.Client.List(ctx, &nbrpList, client.MatchingLabels{netbirdiov1.NBRoutingPeerTemplateLabel: obj.GetName()})
+	if err != nil {
+		ctrl.Log.WithName("NBRoutingPeer").Error(errKubernetesAPI, "error listing NBRoutingPeer", "err", err)
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(nbrpList.Items))
+	for _, nbrp := range nbrpList.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: nbrp.Namespace, Name: nbrp.Name}})
+	}
+
+	return requests
+}
@@ -6,13 +6,16 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"time"
 
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	appsv1 "k8s.io/api/apps/v1"
@@ -40,11 +43,21 @@ var _ = Describe("NBRoutingPeer Controller", func() {
 		var mux *http.ServeMux
 		var server *httptest.Server
 		var controllerReconciler *NBRoutingPeerReconciler
+		var peers []api.Peer
 
 		BeforeEach(func() {
 			ctrl.SetLogger(logr.New(GinkgoLogr.GetSink()))
 			mux = &http.ServeMux{}
 			server = httptest.NewServer(mux)
+			peers = []api.Peer{}
+			mux.HandleFunc("/api/peers", func(w http.ResponseWriter, r *http.Request) {
+				defer GinkgoRecover()
+				Expect(r.Method).To(Equal(http.MethodGet))
+				bs, err := json.Marshal(peers)
+				Expect(err).NotTo(HaveOccurred())
+				_, err = w.Write(bs)
+				Expect(err).NotTo(HaveOccurred())
+			})
 			netbirdClient = netbird.New(server.URL, "ABC")
 			controllerReconciler = &NBRoutingPeerReconciler{
 				Client:             k8sClient,
@@ -54,6 +67,7 @@ var _ = Describe("NBRoutingPeer Controller", func() {
 				ClusterName:        "kubernetes",
 				DefaultLabels:      make(map[string]string),
 				NamespacedNetworks: false,
+				DefaultRouterName:  resourceName,
 			}
 
 			By("creating the custom resource for the Kind NBRoutingPeer")
@@ -155,6 +169,98 @@ var _ = Describe("NBRoutingPeer Controller", func() {
 			}
 		})
 
+		When("Template is referenced", func() {
+			AfterEach(func() {
+				template := &netbirdiov1.NBRoutingPeerTemplate{}
+				err := k8sClient.Get(ctx, types.NamespacedName{Name: resourceName}, template)
+				if !errors.IsNotFound(err) {
+					Expect(err).NotTo(HaveOccurred())
+					Expect(k8sClient.Delete(ctx, template)).To(Succeed())
+				}
+			})
+
+			It("should sync spec from template", func() {
+				template := &netbirdiov1.NBRoutingPeerTemplate{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: resourceName,
+					},
+					Spec: netbirdiov1.NBRoutingPeerTemplateSpec{
+						Template: netbirdiov1.NBRoutingPeerSpec{
+							Replicas:     util.Ptr(int32(2)),
+							NodeSelector: map[string]string{"role": "router"},
+						},
+					},
+				}
+				Expect(k8sClient.Create(ctx, template)).To(Succeed())
+
+				nbroutingpeer.Labels = map[string]string{netbirdiov1.NBRoutingPeerTemplateLabel: resourceName}
+				nbroutingpeer.Spec.Replicas = nil
+				Expect(k8sClient.Update(ctx, nbroutingpeer)).To(Succeed())
+
+				_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+					NamespacedName: typeNamespacedName,
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(k8sClient.Get(ctx, typeNamespacedName, nbroutingpeer)).To(Succeed())
+				Expect(nbroutingpeer.Spec.Replicas).To(BeEquivalentTo(util.Ptr(int32(2))))
+				Expect(nbroutingpeer.Spec.NodeSelector).To(HaveKeyWithValue("role", "router"))
+
+				By("updating the template")
+				Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName}, template)).To(Succeed())
+				template.Spec.Template.Replicas = util.Ptr(int32(4))
+				Expect(k8sClient.Update(ctx, template)).To(Succeed())
+
+				_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{
+					NamespacedName: typeNamespacedName,
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(k8sClient.Get(ctx, typeNamespacedName, nbroutingpeer)).To(Succeed())
+				Expect(nbroutingpeer.Spec.Replicas).To(BeEquivalentTo(util.Ptr(int32(4))))
+			})
+
+			It("should keep fields set on NBRoutingPeer", func() {
+				template := &netbirdiov1.NBRoutingPeerTemplate{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: resourceName,
+					},
+					Spec: netbirdiov1.NBRoutingPeerTemplateSpec{
+						Template: netbirdiov1.NBRoutingPeerSpec{
+							Replicas:     util.Ptr(int32(2)),
+							NodeSelector: map[string]string{"role": "router"},
+						},
+					},
+				}
+				Expect(k8sClient.Create(ctx, template)).To(Succeed())
+
+				nbroutingpeer.Labels = map[string]string{netbirdiov1.NBRoutingPeerTemplateLabel: resourceName}
+				Expect(k8sClient.Update(ctx, nbroutingpeer)).To(Succeed())
+
+				_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+					NamespacedName: typeNamespacedName,
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(k8sClient.Get(ctx, typeNamespacedName, nbroutingpeer)).To(Succeed())
+				Expect(nbroutingpeer.Spec.Replicas).To(BeEquivalentTo(util.Ptr(int32(0))))
+				Expect(nbroutingpeer.Spec.NodeSelector).To(HaveKeyWithValue("role", "router"))
+			})
+
+			It("should reject templates with network ID", func() {
+				template := &netbirdiov1.NBRoutingPeerTemplate{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: resourceName,
+					},
+					Spec: netbirdiov1.NBRoutingPeerTemplateSpec{
+						Template: netbirdiov1.NBRoutingPeerSpec{
+							NetworkID: "test",
+						},
+					},
+				}
+				Expect(k8sClient.Create(ctx, template)).NotTo(Succeed())
+			})
+		})
 		When("Network doesn't exist", func() {
 			BeforeEach(func() {
 				group := &netbirdiov1.NBGroup{
@@ -205,9 +311,136 @@ var _ = Describe("NBRoutingPeer Controller", func() {
 				Expect(nbroutingpeer.Status.NetworkID).NotTo(BeNil())
 				Expect(*nbroutingpeer.Status.NetworkID).To(Equal("test"))
 			})
+			It("should suffix network name of additional routing peers", func() {
+				controllerReconciler.DefaultRouterName = "router"
+				networkCreated := false
+				mux.HandleFunc("/api/networks", func(w http.ResponseWriter, r *http.Request) {
+					defer GinkgoRecover()
+					if r.Method == http.MethodPost {
+						networkCreated = true
+						var req api.PostApiNetworksJSONRequestBody
+						bs, err := io.ReadAll(r.Body)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(json.Unmarshal(bs, &req)).To(Succeed())
+						Expect(req.Name).To(Equal(controllerReconciler.ClusterName + "-" + typeNamespacedName.Name))
+						resp := api.Network{
+							Id:          "test",
+							Description: req.Description,
+							Name:        req.Name,
+						}
+						bs, err = json.Marshal(resp)
+						Expect(err).NotTo(HaveOccurred())
+						_, err = w.Write(bs)
+						Expect(err).NotTo(HaveOccurred())
+					} else if r.Method == http.MethodGet {
+						_, err := w.Write([]byte("[]"))
+						Expect(err).NotTo(HaveOccurred())
+					}
+				})
+				_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+					NamespacedName: typeNamespacedName,
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(networkCreated).To(BeTrue())
+			})
+		})
+		When("Network is referenced by ID", func() {
+			BeforeEach(func() {
+				group := &netbirdiov1.NBGroup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      typeNamespacedName.Name,
+						Namespace: typeNamespacedName.Namespace,
+					},
+					Spec: netbirdiov1.NBGroupSpec{
+						Name: controllerReconciler.ClusterName,
+					},
+				}
+				Expect(k8sClient.Create(ctx, group)).To(Succeed())
+
+				// networkID can only be set on creation
+				nbroutingpeer.Finalizers = nil
+				Expect(k8sClient.Update(ctx, nbroutingpeer)).To(Succeed())
+				Expect(k8sClient.Delete(ctx, nbroutingpeer)).To(Succeed())
+				nbroutingpeer = &netbirdiov1.NBRoutingPeer{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:       resourceName,
+						Namespace:  "default",
+						Finalizers: []string{"netbird.io/cleanup"},
+					},
+					Spec: netbirdiov1.NBRoutingPeerSpec{
+						Replicas:  util.Ptr(int32(0)),
+						NetworkID: "external",
+					},
+				}
+				Expect(k8sClient.Create(ctx, nbroutingpeer)).To(Succeed())
+			})
+			It("should adopt network", func() {
+				mux.HandleFunc("/api/networks/external", func(w http.ResponseWriter, r *http.Request) {
+					defer GinkgoRecover()
+					Expect(r.Method).To(Equal(http.MethodGet))
+					resp := api.Network{
+						Id:   "external",
+						Name: "managed-elsewhere",
+					}
+					bs, err := json.Marshal(resp)
+					Expect(err).NotTo(HaveOccurred())
+					_, err = w.Write(bs)
+					Expect(err).NotTo(HaveOccurred())
+				})
+				_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+					NamespacedName: typeNamespacedName,
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(k8sClient.Get(ctx, typeNamespacedName, nbroutingpeer)).To(Succeed())
+				Expect(nbroutingpeer.Status.NetworkID).NotTo(BeNil())
+				Expect(*nbroutingpeer.Status.NetworkID).To(Equal("external"))
+				Expect(nbroutingpeer.Status.NetworkAdopted).To(BeTrue())
+			})
+			It("should not allow changing network ID", func() {
+				nbroutingpeer.Spec.NetworkID = "other"
+				Expect(k8sClient.Update(ctx, nbroutingpeer)).NotTo(Succeed())
+			})
+			It("should not allow removing network ID", func() {
+				nbroutingpeer.Spec.NetworkID = ""
+				Expect(k8sClient.Update(ctx, nbroutingpeer)).NotTo(Succeed())
+			})
 		})
 		When("Network exists", func() {
+			networkDeleted := false
+			var network api.Network
 			BeforeEach(func() {
+				networkDeleted = false
+				network = api.Network{
+					Id:          "test",
+					Description: &networkDescription,
+					Name:        controllerReconciler.ClusterName,
+				}
+				mux.HandleFunc("/api/networks/test", func(w http.ResponseWriter, r *http.Request) {
+					defer GinkgoRecover()
+					switch r.Method {
+					case http.MethodGet:
+						bs, err := json.Marshal(network)
+						Expect(err).NotTo(HaveOccurred())
+						_, err = w.Write(bs)
+						Expect(err).NotTo(HaveOccurred())
+					case http.MethodPut:
+						var req api.PutApiNetworksNetworkIdJSONRequestBody
+						bs, err := io.ReadAll(r.Body)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(json.Unmarshal(bs, &req)).To(Succeed())
+						network.Name = req.Name
+						network.Description = req.Description
+						bs, err = json.Marshal(network)
+						Expect(err).NotTo(HaveOccurred())
+						_, err = w.Write(bs)
+						Expect(err).NotTo(HaveOccurred())
+					case http.MethodDelete:
+						_, err := w.Write([]byte(`{}`))
+						Expect(err).NotTo(HaveOccurred())
+						networkDeleted = true
+					}
+				})
 				mux.HandleFunc("/api/networks", func(w http.ResponseWriter, r *http.Request) {
 					defer GinkgoRecover()
 					if r.Method == http.MethodGet {
@@ -228,6 +461,87 @@ var _ = Describe("NBRoutingPeer Controller", func() {
 				nbroutingpeer.Status.NetworkID = util.Ptr("test")
 				Expect(k8sClient.Status().Update(ctx, nbroutingpeer)).To(Succeed())
 			})
+			When("Topology is set", func() {
+				zoneName := types.NamespacedName{Namespace: typeNamespacedName.Namespace, Name: typeNamespacedName.Name + "-zone-a"}
+
+				AfterEach(func() {
+					for _, name := range []types.NamespacedName{zoneName, {Namespace: typeNamespacedName.Namespace, Name: typeNamespacedName.Name + "-zone-b"}} {
+						zoneNBRP := &netbirdiov1.NBRoutingPeer{}
+						err := k8sClient.Get(ctx, name, zoneNBRP)
+						if errors.IsNotFound(err) {
+							continue
+						}
+						Expect(err).NotTo(HaveOccurred())
+						zoneNBRP.Finalizers = nil
+						Expect(k8sClient.Update(ctx, zoneNBRP)).To(Succeed())
+						err = k8sClient.Delete(ctx, zoneNBRP)
+						if !errors.IsNotFound(err) {
+							Expect(err).NotTo(HaveOccurred())
+						}
+					}
+				})
+
+				It("should create a routing peer per zone", func() {
+					nbroutingpeer.Spec.Topology = &netbirdiov1.NBRoutingPeerTopology{
+						Zones: []netbirdiov1.NBRoutingPeerZone{
+							{Name: "zone-a", Metric: 100},
+							{Name: "Zone_B", Metric: 200},
+						},
+					}
+					Expect(k8sClient.Update(ctx, nbroutingpeer)).To(Succeed())
+
+					_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+						NamespacedName: typeNamespacedName,
+					})
+					Expect(err).NotTo(HaveOccurred())
+
+					zoneNBRP := &netbirdiov1.NBRoutingPeer{}
+					Expect(k8sClient.Get(ctx, zoneName, zoneNBRP)).To(Succeed())
+					Expect(zoneNBRP.Labels).To(HaveKeyWithValue(netbirdiov1.NBRoutingPeerTopologyLabel, typeNamespacedName.Name))
+					Expect(zoneNBRP.OwnerReferences).To(HaveLen(1))
+					Expect(zoneNBRP.Spec.NetworkID).To(Equal("test"))
+					Expect(zoneNBRP.Spec.RouterMetric).To(Equal(100))
+					Expect(zoneNBRP.Spec.Topology).To(BeNil())
+					Expect(zoneNBRP.Spec.NodeSelector).To(HaveKeyWithValue("topology.kubernetes.io/zone", "zone-a"))
+
+					Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: typeNamespacedName.Namespace, Name: typeNamespacedName.Name + "-zone-b"}, zoneNBRP)).To(Succeed())
+					Expect(zoneNBRP.Spec.RouterMetric).To(Equal(200))
+
+					By("removing a zone")
+					Expect(k8sClient.Get(ctx, typeNamespacedName, nbroutingpeer)).To(Succeed())
+					nbroutingpeer.Spec.Topology.Zones = nbroutingpeer.Spec.Topology.Zones[:1]
+					Expect(k8sClient.Update(ctx, nbroutingpeer)).To(Succeed())
+
+					_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{
+						NamespacedName: typeNamespacedName,
+					})
+					Expect(err).NotTo(HaveOccurred())
+
+					err = k8sClient.Get(ctx, types.NamespacedName{Namespace: typeNamespacedName.Namespace, Name: typeNamespacedName.Name + "-zone-b"}, zoneNBRP)
+					if err == nil {
+						Expect(zoneNBRP.DeletionTimestamp).NotTo(BeNil())
+					} else {
+						Expect(errors.IsNotFound(err)).To(BeTrue())
+					}
+				})
+			})
+			When("Network name is changed", func() {
+				It("should rename network", func() {
+					nbroutingpeer.Spec.NetworkName = "renamed"
+					nbroutingpeer.Spec.NetworkDescription = util.Ptr("Renamed network")
+					Expect(k8sClient.Update(ctx, nbroutingpeer)).To(Succeed())
+
+					_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+						NamespacedName: typeNamespacedName,
+					})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(network.Name).To(Equal("renamed"))
+					Expect(network.Description).To(BeEquivalentTo(util.Ptr("Renamed network")))
+
+					Expect(k8sClient.Get(ctx, typeNamespacedName, nbroutingpeer)).To(Succeed())
+					Expect(*nbroutingpeer.Status.NetworkID).To(Equal("test"))
+				})
+			})
 			Describe("Network Router changes", func() {
 				BeforeEach(func() {
 					group := &netbirdiov1.NBGroup{
@@ -250,8 +564,9 @@ var _ = Describe("NBRoutingPeer Controller", func() {
 					mux.HandleFunc("/api/setup-keys/skid", func(w http.ResponseWriter, r *http.Request) {
 						defer GinkgoRecover()
 						resp := api.SetupKey{
-							Id:      "skid",
-							Revoked: false,
+							Id:        "skid",
+							Revoked:   false,
+							Ephemeral: true,
 						}
 						bs, err := json.Marshal(resp)
 						Expect(err).NotTo(HaveOccurred())
@@ -319,6 +634,58 @@ var _ = Describe("NBRoutingPeer Controller", func() {
 						Expect(*nbroutingpeer.Status.RouterID).To(Equal("test"))
 					})
 				})
+				When("Network Router of another cluster exists", func() {
+					It("should create own network router", func() {
+						routerCreated := false
+						mux.HandleFunc("/api/networks/test/routers", func(w http.ResponseWriter, r *http.Request) {
+							defer GinkgoRecover()
+							if r.Method == http.MethodPost {
+								routerCreated = true
+								var req api.PostApiNetworksNetworkIdRoutersJSONRequestBody
+								bs, err := io.ReadAll(r.Body)
+								Expect(err).NotTo(HaveOccurred())
+								Expect(json.Unmarshal(bs, &req)).To(Succeed())
+								Expect(*req.PeerGroups).To(ConsistOf([]string{"test"}))
+
+								resp := api.NetworkRouter{
+									Id:         "test",
+									Enabled:    true,
+									Masquerade: true,
+									Metric:     9999,
+									PeerGroups: req.PeerGroups,
+								}
+								bs, err = json.Marshal(resp)
+								Expect(err).NotTo(HaveOccurred())
+								_, err = w.Write(bs)
+								Expect(err).NotTo(HaveOccurred())
+							} else if r.Method == http.MethodGet {
+								resp := []api.NetworkRouter{
+									{
+										Id:         "other",
+										Enabled:    true,
+										Masquerade: true,
+										Metric:     9999,
+										PeerGroups: &[]string{"other"},
+									},
+								}
+								bs, err := json.Marshal(resp)
+								Expect(err).NotTo(HaveOccurred())
+								_, err = w.Write(bs)
+								Expect(err).NotTo(HaveOccurred())
+							}
+						})
+
+						_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+							NamespacedName: typeNamespacedName,
+						})
+						Expect(err).NotTo(HaveOccurred())
+						Expect(routerCreated).To(BeTrue())
+
+						Expect(k8sClient.Get(ctx, typeNamespacedName, nbroutingpeer)).To(Succeed())
+						Expect(nbroutingpeer.Status.RouterID).NotTo(BeNil())
+						Expect(*nbroutingpeer.Status.RouterID).To(Equal("test"))
+					})
+				})
 				When("Network Router is out-of-date", func() {
 					It("should update network router", func() {
 						nbroutingpeer.Status.RouterID = util.Ptr("test")
@@ -416,8 +783,9 @@ var _ = Describe("NBRoutingPeer Controller", func() {
 						mux.HandleFunc("/api/setup-keys/skid", func(w http.ResponseWriter, r *http.Request) {
 							defer GinkgoRecover()
 							resp := api.SetupKey{
-								Id:      "skid",
-								Revoked: false,
+								Id:        "skid",
+								Revoked:   false,
+								Ephemeral: true,
 							}
 							bs, err := json.Marshal(resp)
 							Expect(err).NotTo(HaveOccurred())
@@ -557,8 +925,9 @@ var _ = Describe("NBRoutingPeer Controller", func() {
 									defer GinkgoRecover()
 									if r.Method == http.MethodGet {
 										resp := api.SetupKey{
-											Id:      "skid",
-											Revoked: false,
+											Id:        "skid",
+											Revoked:   false,
+											Ephemeral: true,
 										}
 										bs, err := json.Marshal(resp)
 										Expect(err).NotTo(HaveOccurred())
@@ -677,6 +1046,45 @@ var _ = Describe("NBRoutingPeer Controller", func() {
 								Expect(secret.Data["setupKey"]).To(BeEquivalentTo([]byte("SuperSecretKey")))
 							})
 						})
+						When("Setup key ephemeral setting changed", func() {
+							It("should delete old setup key and requeue to regenerate", func() {
+								nbroutingpeer.Spec.Ephemeral = util.Ptr(false)
+								Expect(k8sClient.Update(ctx, nbroutingpeer)).To(Succeed())
+
+								setupKeyDeleted := false
+								mux.HandleFunc("/api/setup-keys/skid", func(w http.ResponseWriter, r *http.Request) {
+									defer GinkgoRecover()
+									if r.Method == http.MethodGet {
+										resp := api.SetupKey{
+											Id:        "skid",
+											Revoked:   false,
+											Ephemeral: true,
+										}
+										bs, err := json.Marshal(resp)
+										Expect(err).NotTo(HaveOccurred())
+										_, err = w.Write(bs)
+										Expect(err).NotTo(HaveOccurred())
+									} else if r.Method == http.MethodDelete {
+										setupKeyDeleted = true
+										_, err := w.Write([]byte(`{}`))
+										Expect(err).NotTo(HaveOccurred())
+									}
+								})
+
+								nbroutingpeer.Status.SetupKeyID = util.Ptr("skid")
+								Expect(k8sClient.Status().Update(ctx, nbroutingpeer)).To(Succeed())
+
+								res, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+									NamespacedName: typeNamespacedName,
+								})
+								Expect(err).NotTo(HaveOccurred())
+								Expect(res.Requeue).To(BeTrue())
+								Expect(setupKeyDeleted).To(BeTrue())
+
+								Expect(k8sClient.Get(ctx, typeNamespacedName, nbroutingpeer)).To(Succeed())
+								Expect(nbroutingpeer.Status.SetupKeyID).To(BeNil())
+							})
+						})
 						When("Setup key is deleted", func() {
 							It("should requeue to recreate", func() {
 								setupKeyCreated := false
@@ -763,8 +1171,9 @@ var _ = Describe("NBRoutingPeer Controller", func() {
 									defer GinkgoRecover()
 									if r.Method == http.MethodGet {
 										resp := api.SetupKey{
-											Id:      "skid",
-											Revoked: false,
+											Id:        "skid",
+											Revoked:   false,
+											Ephemeral: true,
 										}
 										bs, err := json.Marshal(resp)
 										Expect(err).NotTo(HaveOccurred())
@@ -806,15 +1215,18 @@ var _ = Describe("NBRoutingPeer Controller", func() {
 						})
 					})
 					Describe("Deployment Behavior", func() {
+						setupKeyEphemeral := true
 						BeforeEach(func() {
+							setupKeyEphemeral = true
 							nbroutingpeer.Status.SetupKeyID = util.Ptr("skid")
 							Expect(k8sClient.Status().Update(ctx, nbroutingpeer)).To(Succeed())
 
 							mux.HandleFunc("/api/setup-keys/skid", func(w http.ResponseWriter, r *http.Request) {
 								defer GinkgoRecover()
 								resp := api.SetupKey{
-									Id:      "skid",
-									Revoked: false,
+									Id:        "skid",
+									Revoked:   false,
+									Ephemeral: setupKeyEphemeral,
 								}
 								bs, err := json.Marshal(resp)
 								Expect(err).NotTo(HaveOccurred())
@@ -865,6 +1277,12 @@ var _ = Describe("NBRoutingPeer Controller", func() {
 								Expect(k8sClient.Get(ctx, typeNamespacedName, deployment)).To(Succeed())
 								Expect(deployment.Labels).To(HaveKeyWithValue("cat", "meow"))
 								Expect(deployment.Labels).To(HaveKeyWithValue("dog", "bark"))
+								Expect(deployment.Spec.Template.Labels).To(HaveKeyWithValue("dog", "bark"))
+								// Default labels are shared by all reconcilers and must not pick up routing peer labels
+								Expect(controllerReconciler.DefaultLabels).To(Equal(map[string]string{
+									"cat": "meow",
+									"dog": "bark",
+								}))
 							})
 						})
 
@@ -888,42 +1306,554 @@ var _ = Describe("NBRoutingPeer Controller", func() {
 								Expect(k8sClient.Get(ctx, typeNamespacedName, deployment)).To(Succeed())
 								Expect(deployment.Spec.Replicas).To(BeEquivalentTo(util.Ptr(int32(0))))
 							})
-						})
-						When("Deployment is up-to-date", func() {
-							It("should ", func() {
+
+							It("should add annotations to deployment", func() {
 								_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
 									NamespacedName: typeNamespacedName,
 								})
 								Expect(err).NotTo(HaveOccurred())
 
-								deployment := &appsv1.Deployment{}
-								Expect(k8sClient.Get(ctx, typeNamespacedName, deployment)).To(Succeed())
-								resourceVersion := deployment.ResourceVersion
+								Expect(k8sClient.Get(ctx, typeNamespacedName, nbroutingpeer)).To(Succeed())
+								nbroutingpeer.Spec.Annotations = map[string]string{"team": "network"}
+								Expect(k8sClient.Update(ctx, nbroutingpeer)).To(Succeed())
 
 								_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{
 									NamespacedName: typeNamespacedName,
 								})
 								Expect(err).NotTo(HaveOccurred())
-								deployment = &appsv1.Deployment{}
+								deployment := &appsv1.Deployment{}
 								Expect(k8sClient.Get(ctx, typeNamespacedName, deployment)).To(Succeed())
-								Expect(deployment.ResourceVersion).To(Equal(resourceVersion))
+								Expect(deployment.Annotations).To(HaveKeyWithValue("team", "network"))
 							})
-						})
-					})
-					When("NBRoutingPeer is set for deletion", func() {
-						networkDeleted := false
-						routerDeleted := false
-						BeforeEach(func() {
-							networkDeleted = false
-							routerDeleted = false
-							nbroutingpeer.Status.SetupKeyID = util.Ptr("skid")
-							Expect(k8sClient.Status().Update(ctx, nbroutingpeer)).To(Succeed())
+
+							It("should keep selector of deployments created by earlier versions", func() {
+								legacySelector := map[string]string{"app.kubernetes.io/name": "netbird-router"}
+								deployment := &appsv1.Deployment{
+									ObjectMeta: metav1.ObjectMeta{
+										Name:      typeNamespacedName.Name,
+										Namespace: typeNamespacedName.Namespace,
+									},
+									Spec: appsv1.DeploymentSpec{
+										Selector: &metav1.LabelSelector{MatchLabels: legacySelector},
+										Template: corev1.PodTemplateSpec{
+											ObjectMeta: metav1.ObjectMeta{Labels: legacySelector},
+											Spec: corev1.PodSpec{
+												Containers: []corev1.Container{{Name: "netbird", Image: "netbirdio/netbird:latest"}},
+											},
+										},
+									},
+								}
+								Expect(k8sClient.Create(ctx, deployment)).To(Succeed())
+								uid := deployment.UID
+
+								_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+									NamespacedName: typeNamespacedName,
+								})
+								Expect(err).NotTo(HaveOccurred())
+
+								deployment = &appsv1.Deployment{}
+								Expect(k8sClient.Get(ctx, typeNamespacedName, deployment)).To(Succeed())
+								Expect(deployment.UID).To(Equal(uid))
+								Expect(deployment.Spec.Selector.MatchLabels).To(Equal(legacySelector))
+								Expect(deployment.Spec.Template.Labels).To(HaveKeyWithValue("app.kubernetes.io/instance", typeNamespacedName.Name))
+							})
+						})
+						When("Routing peers are registered", func() {
+							It("should report peers and health in status", func() {
+								peers = []api.Peer{
+									{
+										Id:        "peer-b",
+										Hostname:  "test-resource-b",
+										Ip:        "100.64.0.2",
+										Connected: false,
+										Groups:    []api.GroupMinimum{{Id: "test"}},
+									},
+									{
+										Id:        "peer-a",
+										Hostname:  "test-resource-a",
+										Ip:        "100.64.0.1",
+										Connected: true,
+										Groups:    []api.GroupMinimum{{Id: "test"}},
+									},
+									{
+										Id:        "peer-c",
+										Hostname:  "other",
+										Ip:        "100.64.0.3",
+										Connected: true,
+										Groups:    []api.GroupMinimum{{Id: "other"}},
+									},
+								}
+
+								_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+									NamespacedName: typeNamespacedName,
+								})
+								Expect(err).NotTo(HaveOccurred())
+
+								Expect(k8sClient.Get(ctx, typeNamespacedName, nbroutingpeer)).To(Succeed())
+								Expect(nbroutingpeer.Status.Peers).To(HaveLen(2))
+								Expect(nbroutingpeer.Status.Peers[0].ID).To(Equal("peer-a"))
+								Expect(nbroutingpeer.Status.Peers[0].IP).To(Equal("100.64.0.1"))
+								Expect(nbroutingpeer.Status.Peers[0].Connected).To(BeTrue())
+								Expect(nbroutingpeer.Status.Peers[1].ID).To(Equal("peer-b"))
+								Expect(nbroutingpeer.Status.Peers[1].Connected).To(BeFalse())
+								Expect(nbroutingpeer.Status.ConnectedPeers).To(BeEquivalentTo(1))
+
+								var readyCondition, deploymentCondition, peersCondition *netbirdiov1.NBCondition
+								for i, c := range nbroutingpeer.Status.Conditions {
+									switch c.Type {
+									case netbirdiov1.NBRoutingPeerReady:
+										readyCondition = &nbroutingpeer.Status.Conditions[i]
+									case netbirdiov1.NBRoutingPeerDeploymentAvailable:
+										deploymentCondition = &nbroutingpeer.Status.Conditions[i]
+									case netbirdiov1.NBRoutingPeerPeersConnected:
+										peersCondition = &nbroutingpeer.Status.Conditions[i]
+									}
+								}
+								Expect(readyCondition).NotTo(BeNil())
+								Expect(readyCondition.Status).To(Equal(corev1.ConditionFalse))
+								Expect(deploymentCondition).NotTo(BeNil())
+								Expect(deploymentCondition.Status).To(Equal(corev1.ConditionFalse))
+								Expect(peersCondition).NotTo(BeNil())
+								Expect(peersCondition.Status).To(Equal(corev1.ConditionTrue))
+							})
+						})
+
+						When("Metrics are enabled", func() {
+							It("should add metrics sidecar and Service", func() {
+								nbroutingpeer.Spec.Metrics = &netbirdiov1.NBRoutingPeerMetrics{
+									Image: "example.com/netbird-exporter:latest",
+									ServiceMonitor: &netbirdiov1.NBRoutingPeerServiceMonitor{
+										Interval: "30s",
+									},
+								}
+								Expect(k8sClient.Update(ctx, nbroutingpeer)).To(Succeed())
+
+								_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+									NamespacedName: typeNamespacedName,
+								})
+								Expect(err).NotTo(HaveOccurred())
+
+								deployment := &appsv1.Deployment{}
+								Expect(k8sClient.Get(ctx, typeNamespacedName, deployment)).To(Succeed())
+								Expect(deployment.Spec.Template.Spec.Containers).To(HaveLen(2))
+								Expect(deployment.Spec.Template.Spec.Containers[0].Env).To(ContainElement(corev1.EnvVar{
+									Name:  "NB_DAEMON_ADDR",
+									Value: "unix:///var/run/netbird/netbird.sock",
+								}))
+								Expect(deployment.Spec.Template.Spec.Containers[1].Name).To(Equal("metrics"))
+								Expect(deployment.Spec.Template.Spec.Containers[1].Image).To(Equal("example.com/netbird-exporter:latest"))
+								Expect(deployment.Spec.Template.Spec.Containers[1].Ports).To(HaveLen(1))
+								Expect(deployment.Spec.Template.Spec.Containers[1].Ports[0].ContainerPort).To(BeEquivalentTo(9090))
+								Expect(deployment.Spec.Template.Spec.Volumes).To(HaveLen(1))
+								Expect(deployment.Spec.Template.Spec.Volumes[0].Name).To(Equal("netbird-daemon"))
+
+								metricsName := types.NamespacedName{Namespace: typeNamespacedName.Namespace, Name: typeNamespacedName.Name + "-metrics"}
+								svc := &corev1.Service{}
+								Expect(k8sClient.Get(ctx, metricsName, svc)).To(Succeed())
+								Expect(svc.Spec.Ports).To(HaveLen(1))
+								Expect(svc.Spec.Ports[0].Port).To(BeEquivalentTo(9090))
+
+								By("disabling metrics")
+								Expect(k8sClient.Get(ctx, typeNamespacedName, nbroutingpeer)).To(Succeed())
+								nbroutingpeer.Spec.Metrics = nil
+								Expect(k8sClient.Update(ctx, nbroutingpeer)).To(Succeed())
+
+								_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{
+									NamespacedName: typeNamespacedName,
+								})
+								Expect(err).NotTo(HaveOccurred())
+
+								Expect(k8sClient.Get(ctx, typeNamespacedName, deployment)).To(Succeed())
+								Expect(deployment.Spec.Template.Spec.Containers).To(HaveLen(1))
+								Expect(k8sClient.Get(ctx, metricsName, svc)).NotTo(Succeed())
+							})
+						})
+
+						When("Cluster CIDRs are exposed", func() {
+							It("should create NBResources for cluster CIDRs", func() {
+								nbroutingpeer.Spec.ClusterCIDRs = &netbirdiov1.NBRoutingPeerClusterCIDRs{
+									Groups:       []string{"cluster-cidrs"},
+									Services:     true,
+									ServiceCIDRs: []string{"10.96.0.0/12"},
+								}
+								Expect(k8sClient.Update(ctx, nbroutingpeer)).To(Succeed())
+
+								_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+									NamespacedName: typeNamespacedName,
+								})
+								Expect(err).NotTo(HaveOccurred())
+
+								nbResourceName := types.NamespacedName{Namespace: typeNamespacedName.Namespace, Name: typeNamespacedName.Name + "-services-10-96-0-0-12"}
+								nbResource := &netbirdiov1.NBResource{}
+								Expect(k8sClient.Get(ctx, nbResourceName, nbResource)).To(Succeed())
+								Expect(nbResource.Spec.Address).To(Equal("10.96.0.0/12"))
+								Expect(nbResource.Spec.NetworkID).To(Equal("test"))
+								Expect(nbResource.Spec.Groups).To(ConsistOf("cluster-cidrs"))
+								Expect(nbResource.Labels).To(HaveKeyWithValue("netbird.io/cluster-cidr", typeNamespacedName.Name))
+
+								Expect(k8sClient.Get(ctx, typeNamespacedName, nbroutingpeer)).To(Succeed())
+								Expect(nbroutingpeer.Status.ClusterCIDRs).To(ConsistOf("10.96.0.0/12"))
+
+								By("disabling cluster CIDRs")
+								nbroutingpeer.Spec.ClusterCIDRs = nil
+								Expect(k8sClient.Update(ctx, nbroutingpeer)).To(Succeed())
+
+								_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{
+									NamespacedName: typeNamespacedName,
+								})
+								Expect(err).NotTo(HaveOccurred())
+
+								Expect(k8sClient.Get(ctx, nbResourceName, nbResource)).To(Succeed())
+								Expect(nbResource.DeletionTimestamp).NotTo(BeNil())
+								nbResource.Finalizers = nil
+								Expect(k8sClient.Update(ctx, nbResource)).To(Succeed())
+
+								Expect(k8sClient.Get(ctx, typeNamespacedName, nbroutingpeer)).To(Succeed())
+								Expect(nbroutingpeer.Status.ClusterCIDRs).To(BeEmpty())
+							})
+						})
+
+						When("Exit node is enabled", func() {
+							It("should create exit node NBRoute", func() {
+								nbroutingpeer.Spec.ExitNode = &netbirdiov1.NBRoutingPeerExitNode{
+									Groups: []string{"egress"},
+								}
+								Expect(k8sClient.Update(ctx, nbroutingpeer)).To(Succeed())
+
+								_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+									NamespacedName: typeNamespacedName,
+								})
+								Expect(err).NotTo(HaveOccurred())
+
+								nbGroup := &netbirdiov1.NBGroup{}
+								Expect(k8sClient.Get(ctx, typeNamespacedName, nbGroup)).To(Succeed())
+
+								exitNodeName := types.NamespacedName{Namespace: typeNamespacedName.Namespace, Name: typeNamespacedName.Name + "-exit-node"}
+								nbRoute := &netbirdiov1.NBRoute{}
+								Expect(k8sClient.Get(ctx, exitNodeName, nbRoute)).To(Succeed())
+								Expect(nbRoute.Spec.Network).To(Equal("0.0.0.0/0"))
+								Expect(nbRoute.Spec.PeerGroups).To(ConsistOf(nbGroup.Spec.Name))
+								Expect(nbRoute.Spec.Groups).To(ConsistOf("egress"))
+								Expect(nbRoute.Spec.Masquerade).To(BeEquivalentTo(util.Ptr(true)))
+								Expect(nbRoute.OwnerReferences).To(HaveLen(1))
+								Expect(nbRoute.OwnerReferences[0].UID).To(Equal(nbroutingpeer.UID))
+
+								By("disabling exit node")
+								Expect(k8sClient.Get(ctx, typeNamespacedName, nbroutingpeer)).To(Succeed())
+								nbroutingpeer.Spec.ExitNode = nil
+								Expect(k8sClient.Update(ctx, nbroutingpeer)).To(Succeed())
+
+								_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{
+									NamespacedName: typeNamespacedName,
+								})
+								Expect(err).NotTo(HaveOccurred())
+
+								Expect(k8sClient.Get(ctx, exitNodeName, nbRoute)).NotTo(Succeed())
+							})
+						})
+
+						When("Unhealthy action is set", func() {
+							It("should disable network router while no pods are available", func() {
+								recorder := record.NewFakeRecorder(10)
+								controllerReconciler.Recorder = recorder
+								nbroutingpeer.Spec.UnhealthyAction = netbirdiov1.NBRoutingPeerUnhealthyDisable
+								Expect(k8sClient.Update(ctx, nbroutingpeer)).To(Succeed())
+
+								routerUpdated := false
+								mux.HandleFunc("/api/networks/test/routers/test", func(w http.ResponseWriter, r *http.Request) {
+									defer GinkgoRecover()
+									Expect(r.Method).To(Equal(http.MethodPut))
+									routerUpdated = true
+									var req api.PutApiNetworksNetworkIdRoutersRouterIdJSONRequestBody
+									bs, err := io.ReadAll(r.Body)
+									Expect(err).NotTo(HaveOccurred())
+									Expect(json.Unmarshal(bs, &req)).To(Succeed())
+									Expect(req.Enabled).To(BeFalse())
+									Expect(req.Metric).To(Equal(9999))
+
+									resp := api.NetworkRouter{
+										Id:         "test",
+										Enabled:    req.Enabled,
+										Masquerade: req.Masquerade,
+										Metric:     req.Metric,
+										PeerGroups: req.PeerGroups,
+									}
+									bs, err = json.Marshal(resp)
+									Expect(err).NotTo(HaveOccurred())
+									_, err = w.Write(bs)
+									Expect(err).NotTo(HaveOccurred())
+								})
+
+								By("creating the Deployment")
+								_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+									NamespacedName: typeNamespacedName,
+								})
+								Expect(err).NotTo(HaveOccurred())
+								Expect(routerUpdated).To(BeFalse())
+
+								By("disabling the router without available replicas")
+								_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{
+									NamespacedName: typeNamespacedName,
+								})
+								Expect(err).NotTo(HaveOccurred())
+								Expect(routerUpdated).To(BeTrue())
+								Expect(recorder.Events).To(Receive(ContainSubstring("RouterDegraded")))
+
+								Expect(k8sClient.Get(ctx, typeNamespacedName, nbroutingpeer)).To(Succeed())
+								Expect(nbroutingpeer.Status.RouterDegraded).To(BeTrue())
+
+								By("restoring the router once replicas are available")
+								deployment := &appsv1.Deployment{}
+								Expect(k8sClient.Get(ctx, typeNamespacedName, deployment)).To(Succeed())
+								deployment.Status.Replicas = 1
+								deployment.Status.UpdatedReplicas = 1
+								deployment.Status.ReadyReplicas = 1
+								deployment.Status.AvailableReplicas = 1
+								Expect(k8sClient.Status().Update(ctx, deployment)).To(Succeed())
+
+								_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{
+									NamespacedName: typeNamespacedName,
+								})
+								Expect(err).NotTo(HaveOccurred())
+								Expect(recorder.Events).To(Receive(ContainSubstring("RouterRestored")))
+
+								Expect(k8sClient.Get(ctx, typeNamespacedName, nbroutingpeer)).To(Succeed())
+								Expect(nbroutingpeer.Status.RouterDegraded).To(BeFalse())
+							})
+
+							It("should raise network router metric while no pods are available", func() {
+								controllerReconciler.Recorder = record.NewFakeRecorder(10)
+								nbroutingpeer.Spec.RouterMetric = 100
+								nbroutingpeer.Spec.UnhealthyAction = netbirdiov1.NBRoutingPeerUnhealthyRaiseMetric
+								Expect(k8sClient.Update(ctx, nbroutingpeer)).To(Succeed())
+
+								var metrics []int
+								mux.HandleFunc("/api/networks/test/routers/test", func(w http.ResponseWriter, r *http.Request) {
+									defer GinkgoRecover()
+									Expect(r.Method).To(Equal(http.MethodPut))
+									var req api.PutApiNetworksNetworkIdRoutersRouterIdJSONRequestBody
+									bs, err := io.ReadAll(r.Body)
+									Expect(err).NotTo(HaveOccurred())
+									Expect(json.Unmarshal(bs, &req)).To(Succeed())
+									metrics = append(metrics, req.Metric)
+
+									resp := api.NetworkRouter{
+										Id:         "test",
+										Enabled:    req.Enabled,
+										Masquerade: req.Masquerade,
+										Metric:     req.Metric,
+										PeerGroups: req.PeerGroups,
+									}
+									bs, err = json.Marshal(resp)
+									Expect(err).NotTo(HaveOccurred())
+									_, err = w.Write(bs)
+									Expect(err).NotTo(HaveOccurred())
+								})
+
+								By("creating the Deployment with the healthy metric")
+								_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+									NamespacedName: typeNamespacedName,
+								})
+								Expect(err).NotTo(HaveOccurred())
+								Expect(metrics).To(Equal([]int{100}))
+
+								req := reconcile.Request{NamespacedName: typeNamespacedName}
+								Expect(k8sClient.Get(ctx, typeNamespacedName, nbroutingpeer)).To(Succeed())
+
+								By("raising the metric without available replicas")
+								enabled, metric, err := controllerReconciler.routerSettings(ctx, req, nbroutingpeer, ctrl.Log)
+								Expect(err).NotTo(HaveOccurred())
+								Expect(enabled).To(BeTrue())
+								Expect(metric).To(Equal(netbirdiov1.NBRouterMaxMetric))
+								Expect(metric).NotTo(Equal(nbroutingpeer.Spec.RouterMetric))
+
+								By("restoring the metric once replicas are available")
+								deployment := &appsv1.Deployment{}
+								Expect(k8sClient.Get(ctx, typeNamespacedName, deployment)).To(Succeed())
+								deployment.Status.Replicas = 1
+								deployment.Status.UpdatedReplicas = 1
+								deployment.Status.ReadyReplicas = 1
+								deployment.Status.AvailableReplicas = 1
+								Expect(k8sClient.Status().Update(ctx, deployment)).To(Succeed())
+
+								enabled, metric, err = controllerReconciler.routerSettings(ctx, req, nbroutingpeer, ctrl.Log)
+								Expect(err).NotTo(HaveOccurred())
+								Expect(enabled).To(BeTrue())
+								Expect(metric).To(Equal(100))
+							})
+						})
+
+						When("Workload type is StatefulSet", func() {
+							It("should create StatefulSet with persistent client state", func() {
+								setupKeyEphemeral = false
+								nbroutingpeer.Spec.Ephemeral = util.Ptr(false)
+								nbroutingpeer.Spec.WorkloadType = netbirdiov1.NBRoutingPeerWorkloadStatefulSet
+								Expect(k8sClient.Update(ctx, nbroutingpeer)).To(Succeed())
+
+								peers = []api.Peer{
+									{
+										Id:        "peer-1",
+										Hostname:  "test-resource-1",
+										Ip:        "100.64.0.1",
+										Connected: true,
+										Groups:    []api.GroupMinimum{{Id: "test"}},
+									},
+								}
+
+								_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+									NamespacedName: typeNamespacedName,
+								})
+								Expect(err).NotTo(HaveOccurred())
+
+								statefulSet := &appsv1.StatefulSet{}
+								Expect(k8sClient.Get(ctx, typeNamespacedName, statefulSet)).To(Succeed())
+								defer func() {
+									Expect(k8sClient.Delete(ctx, statefulSet)).To(Succeed())
+								}()
+								Expect(statefulSet.Spec.VolumeClaimTemplates).To(HaveLen(1))
+								Expect(statefulSet.Spec.VolumeClaimTemplates[0].Name).To(Equal("netbird-state"))
+								Expect(statefulSet.Spec.Template.Spec.Containers[0].Env).To(ContainElement(corev1.EnvVar{
+									Name:  "NB_CONFIG",
+									Value: "/var/lib/netbird/config.json",
+								}))
+								Expect(statefulSet.Spec.Template.Spec.Containers[0].VolumeMounts).To(ContainElement(corev1.VolumeMount{
+									Name:      "netbird-state",
+									MountPath: "/var/lib/netbird",
+								}))
+								Expect(k8sClient.Get(ctx, typeNamespacedName, &appsv1.Deployment{})).NotTo(Succeed())
+
+								Expect(k8sClient.Get(ctx, typeNamespacedName, nbroutingpeer)).To(Succeed())
+								Expect(nbroutingpeer.Status.Peers).To(HaveLen(1))
+								Expect(nbroutingpeer.Status.Peers[0].Ordinal).To(BeEquivalentTo(util.Ptr(int32(1))))
+							})
+
+							It("should require non-ephemeral routing peers", func() {
+								nbroutingpeer.Spec.WorkloadType = netbirdiov1.NBRoutingPeerWorkloadStatefulSet
+								Expect(k8sClient.Update(ctx, nbroutingpeer)).NotTo(Succeed())
+							})
+						})
+
+						When("Routing peers are not ephemeral", func() {
+							It("should delete stale peers", func() {
+								setupKeyEphemeral = false
+								nbroutingpeer.Spec.Ephemeral = util.Ptr(false)
+								Expect(k8sClient.Update(ctx, nbroutingpeer)).To(Succeed())
+
+								// Create Deployment
+								_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+									NamespacedName: typeNamespacedName,
+								})
+								Expect(err).NotTo(HaveOccurred())
+
+								pod := &corev1.Pod{
+									ObjectMeta: metav1.ObjectMeta{
+										Name:      "test-resource-live",
+										Namespace: typeNamespacedName.Namespace,
+										Labels: map[string]string{
+											"app.kubernetes.io/name":     "netbird-router",
+											"app.kubernetes.io/instance": typeNamespacedName.Name,
+										},
+										Annotations: map[string]string{
+											"netbird.io/peer-hostname": "renamed-host",
+										},
+									},
+									Spec: corev1.PodSpec{
+										Containers: []corev1.Container{
+											{
+												Name:  "netbird",
+												Image: "netbirdio/netbird:latest",
+											},
+										},
+									},
+								}
+								Expect(k8sClient.Create(ctx, pod)).To(Succeed())
+								DeferCleanup(func() {
+									Expect(k8sClient.Delete(ctx, pod)).To(Succeed())
+								})
+
+								peers = []api.Peer{
+									{
+										Id:       "live",
+										Hostname: "test-resource-live",
+										Groups:   []api.GroupMinimum{{Id: "test"}},
+									},
+									{
+										Id:       "annotated",
+										Hostname: "renamed-host",
+										Groups:   []api.GroupMinimum{{Id: "test"}},
+									},
+									{
+										Id:        "connected",
+										Hostname:  "test-resource-other",
+										Connected: true,
+										Groups:    []api.GroupMinimum{{Id: "test"}},
+									},
+									{
+										Id:       "ghost",
+										Hostname: "test-resource-gone",
+										Groups:   []api.GroupMinimum{{Id: "test"}},
+									},
+								}
+
+								var deletedPeers []string
+								for _, p := range peers {
+									id := p.Id
+									mux.HandleFunc("/api/peers/"+id, func(w http.ResponseWriter, r *http.Request) {
+										defer GinkgoRecover()
+										Expect(r.Method).To(Equal(http.MethodDelete))
+										deletedPeers = append(deletedPeers, id)
+										_, err := w.Write([]byte(`{}`))
+										Expect(err).NotTo(HaveOccurred())
+									})
+								}
+
+								_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{
+									NamespacedName: typeNamespacedName,
+								})
+								Expect(err).NotTo(HaveOccurred())
+								Expect(deletedPeers).To(ConsistOf("ghost"))
+
+								Expect(k8sClient.Get(ctx, typeNamespacedName, nbroutingpeer)).To(Succeed())
+								Expect(nbroutingpeer.Status.Peers).To(HaveLen(3))
+							})
+						})
+
+						When("Deployment is up-to-date", func() {
+							It("should ", func() {
+								_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+									NamespacedName: typeNamespacedName,
+								})
+								Expect(err).NotTo(HaveOccurred())
+
+								deployment := &appsv1.Deployment{}
+								Expect(k8sClient.Get(ctx, typeNamespacedName, deployment)).To(Succeed())
+								resourceVersion := deployment.ResourceVersion
+
+								_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{
+									NamespacedName: typeNamespacedName,
+								})
+								Expect(err).NotTo(HaveOccurred())
+								deployment = &appsv1.Deployment{}
+								Expect(k8sClient.Get(ctx, typeNamespacedName, deployment)).To(Succeed())
+								Expect(deployment.ResourceVersion).To(Equal(resourceVersion))
+							})
+						})
+					})
+					When("NBRoutingPeer is set for deletion", func() {
+						routerDeleted := false
+						var routerPeerGroups *[]string
+						BeforeEach(func() {
+							routerDeleted = false
+							routerPeerGroups = &[]string{"test"}
+							nbroutingpeer.Status.SetupKeyID = util.Ptr("skid")
+							Expect(k8sClient.Status().Update(ctx, nbroutingpeer)).To(Succeed())
 
 							mux.HandleFunc("/api/setup-keys/skid", func(w http.ResponseWriter, r *http.Request) {
 								defer GinkgoRecover()
 								resp := api.SetupKey{
-									Id:      "skid",
-									Revoked: false,
+									Id:        "skid",
+									Revoked:   false,
+									Ephemeral: true,
 								}
 								bs, err := json.Marshal(resp)
 								Expect(err).NotTo(HaveOccurred())
@@ -946,16 +1876,21 @@ var _ = Describe("NBRoutingPeer Controller", func() {
 							})
 							Expect(err).NotTo(HaveOccurred())
 
-							mux.HandleFunc("/api/networks/test", func(w http.ResponseWriter, r *http.Request) {
-								defer GinkgoRecover()
-								Expect(r.Method).To(Equal(http.MethodDelete))
-								_, err = w.Write([]byte(`{}`))
-								Expect(err).NotTo(HaveOccurred())
-								networkDeleted = true
-							})
-
 							mux.HandleFunc("/api/networks/test/routers/test", func(w http.ResponseWriter, r *http.Request) {
 								defer GinkgoRecover()
+								if r.Method == http.MethodGet {
+									bs, err := json.Marshal(api.NetworkRouter{
+										Id:         "test",
+										Enabled:    true,
+										Masquerade: true,
+										Metric:     9999,
+										PeerGroups: routerPeerGroups,
+									})
+									Expect(err).NotTo(HaveOccurred())
+									_, err = w.Write(bs)
+									Expect(err).NotTo(HaveOccurred())
+									return
+								}
 								Expect(r.Method).To(Equal(http.MethodDelete))
 								_, err = w.Write([]byte(`{}`))
 								Expect(err).NotTo(HaveOccurred())
@@ -992,6 +1927,45 @@ var _ = Describe("NBRoutingPeer Controller", func() {
 							Expect(networkDeleted).To(BeTrue())
 						})
 
+						It("should not delete Network used by other clusters", func() {
+							network.Routers = []string{"other"}
+
+							Expect(k8sClient.Delete(ctx, nbroutingpeer)).To(Succeed())
+							_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+								NamespacedName: typeNamespacedName,
+							})
+							Expect(err).NotTo(HaveOccurred())
+							Expect(routerDeleted).To(BeTrue())
+							Expect(networkDeleted).To(BeFalse())
+
+							Expect(k8sClient.Get(ctx, typeNamespacedName, nbroutingpeer)).NotTo(Succeed())
+						})
+
+						It("should not delete adopted Network", func() {
+							Expect(k8sClient.Get(ctx, typeNamespacedName, nbroutingpeer)).To(Succeed())
+							nbroutingpeer.Status.NetworkAdopted = true
+							Expect(k8sClient.Status().Update(ctx, nbroutingpeer)).To(Succeed())
+
+							Expect(k8sClient.Delete(ctx, nbroutingpeer)).To(Succeed())
+							_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+								NamespacedName: typeNamespacedName,
+							})
+							Expect(err).NotTo(HaveOccurred())
+							Expect(networkDeleted).To(BeFalse())
+							Expect(routerDeleted).To(BeTrue())
+						})
+
+						It("should not delete Network router of another cluster", func() {
+							routerPeerGroups = &[]string{"other"}
+
+							Expect(k8sClient.Delete(ctx, nbroutingpeer)).To(Succeed())
+							_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+								NamespacedName: typeNamespacedName,
+							})
+							Expect(err).NotTo(HaveOccurred())
+							Expect(routerDeleted).To(BeFalse())
+						})
+
 						It("should delete deployment", func() {
 							Expect(k8sClient.Delete(ctx, nbroutingpeer)).To(Succeed())
 							_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
@@ -1003,6 +1977,36 @@ var _ = Describe("NBRoutingPeer Controller", func() {
 							Expect(errors.IsNotFound(err)).To(BeTrue())
 						})
 
+						It("should not wait for NBResources of other networks", func() {
+							nbResource := &netbirdiov1.NBResource{
+								ObjectMeta: metav1.ObjectMeta{
+									Name:       "other-network",
+									Namespace:  typeNamespacedName.Namespace,
+									Finalizers: []string{"netbird.io/cleanup"},
+								},
+								Spec: netbirdiov1.NBResourceSpec{
+									Name:      "other",
+									NetworkID: "other",
+									Address:   "other",
+									Groups:    []string{"grp"},
+								},
+							}
+							Expect(k8sClient.Create(ctx, nbResource)).To(Succeed())
+							defer func() {
+								nbResource.Finalizers = nil
+								Expect(k8sClient.Update(ctx, nbResource)).To(Succeed())
+								Expect(k8sClient.Delete(ctx, nbResource)).To(Succeed())
+							}()
+
+							Expect(k8sClient.Delete(ctx, nbroutingpeer)).To(Succeed())
+							_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+								NamespacedName: typeNamespacedName,
+							})
+							Expect(err).NotTo(HaveOccurred())
+							Expect(networkDeleted).To(BeTrue())
+							Expect(k8sClient.Get(ctx, typeNamespacedName, nbroutingpeer)).NotTo(Succeed())
+						})
+
 						It("should delete any hanging NBResources", func() {
 							nbResource := &netbirdiov1.NBResource{
 								ObjectMeta: metav1.ObjectMeta{
@@ -1031,4 +2035,147 @@ var _ = Describe("NBRoutingPeer Controller", func() {
 			})
 		})
 	})
+
+	Context("When checking routing peer group peers", func() {
+		pods := []corev1.Pod{
+			{ObjectMeta: metav1.ObjectMeta{Name: "router-0"}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "router-1"}},
+		}
+
+		It("should not report peers of own pods", func() {
+			Expect(clusterNameConflict([]api.Peer{
+				{Hostname: "router-0", Connected: true},
+				{Hostname: "router-1", Connected: true},
+				{Hostname: "gone", Connected: false},
+			}, pods)).To(BeFalse())
+		})
+
+		It("should report connected peers of other clusters", func() {
+			Expect(clusterNameConflict([]api.Peer{
+				{Hostname: "router-0", Connected: true},
+				{Hostname: "router-abcde", Connected: true},
+			}, pods)).To(BeTrue())
+		})
+
+		It("should report more connected peers than pods", func() {
+			Expect(clusterNameConflict([]api.Peer{
+				{Hostname: "router-0", Connected: true},
+				{Hostname: "router-0", Connected: true},
+				{Hostname: "router-1", Connected: true},
+			}, pods)).To(BeTrue())
+		})
+
+		It("should ignore peers last seen before pods started", func() {
+			started := []corev1.Pod{
+				{ObjectMeta: metav1.ObjectMeta{Name: "router-abcde"}, Status: corev1.PodStatus{StartTime: &metav1.Time{Time: time.Now().Add(-time.Minute)}}},
+			}
+			Expect(clusterNameConflict([]api.Peer{
+				{Hostname: "router-abcde", Connected: true, LastSeen: time.Now()},
+				{Hostname: "router-fghij", Connected: true, LastSeen: time.Now().Add(-time.Hour)},
+			}, started)).To(BeFalse())
+		})
+
+		It("should report conflicts only after the grace period", func() {
+			now := time.Now()
+			condition := clusterNameConflictCondition(nil, true, "test", now)
+			Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+			Expect(condition.Reason).To(Equal("ConflictSuspected"))
+
+			condition.LastTransitionTime = metav1.NewTime(now.Add(-time.Minute))
+			condition = clusterNameConflictCondition([]netbirdiov1.NBCondition{condition}, true, "test", now)
+			Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+
+			condition.LastTransitionTime = metav1.NewTime(now.Add(-clusterNameConflictGracePeriod))
+			condition = clusterNameConflictCondition([]netbirdiov1.NBCondition{condition}, true, "test", now)
+			Expect(condition.Status).To(Equal(corev1.ConditionTrue))
+
+			condition = clusterNameConflictCondition([]netbirdiov1.NBCondition{condition}, true, "test", now)
+			Expect(condition.Status).To(Equal(corev1.ConditionTrue))
+
+			condition = clusterNameConflictCondition([]netbirdiov1.NBCondition{condition}, false, "test", now)
+			Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+			Expect(condition.Reason).To(Equal("NoConflict"))
+		})
+	})
+
+	Context("When updating conditions", func() {
+		It("should keep transition time when only the message changes", func() {
+			transitionTime := metav1.NewTime(time.Now().Add(-time.Hour)).Rfc3339Copy()
+			existing := []netbirdiov1.NBCondition{{
+				Type:               netbirdiov1.NBRoutingPeerPeersConnected,
+				Status:             corev1.ConditionTrue,
+				Reason:             "PeersConnected",
+				Message:            "1/2 peers connected",
+				LastTransitionTime: transitionTime,
+			}}
+
+			conditions := keepConditionTimes(existing, []netbirdiov1.NBCondition{
+				routingPeerCondition(netbirdiov1.NBRoutingPeerPeersConnected, true, "PeersConnected", "2/2 peers connected"),
+			})
+			Expect(conditions[0].LastTransitionTime).To(Equal(transitionTime))
+			Expect(conditions[0].Message).To(Equal("2/2 peers connected"))
+
+			conditions = keepConditionTimes(existing, []netbirdiov1.NBCondition{
+				routingPeerCondition(netbirdiov1.NBRoutingPeerPeersConnected, false, "NoPeersConnected", "0/2 peers connected"),
+			})
+			Expect(conditions[0].LastTransitionTime).NotTo(Equal(transitionTime))
+		})
+	})
+
+	Context("When merging templates", func() {
+		It("should only replace fields owned by the template", func() {
+			applied := netbirdiov1.NBRoutingPeerSpec{
+				Replicas:     util.Ptr(int32(2)),
+				NodeSelector: map[string]string{"role": "router"},
+			}
+			template := netbirdiov1.NBRoutingPeerSpec{
+				Replicas:     util.Ptr(int32(4)),
+				NodeSelector: map[string]string{"role": "edge"},
+				Ephemeral:    util.Ptr(false),
+				Annotations:  map[string]string{"team": "platform"},
+			}
+			spec := netbirdiov1.NBRoutingPeerSpec{
+				NetworkID:    "network",
+				Replicas:     util.Ptr(int32(2)),
+				NodeSelector: map[string]string{"role": "gateway"},
+				Ephemeral:    util.Ptr(true),
+			}
+
+			merged := templateSpec(spec, template, applied)
+			Expect(merged.NetworkID).To(Equal("network"))
+			// Still at previously applied value
+			Expect(merged.Replicas).To(BeEquivalentTo(util.Ptr(int32(4))))
+			// Set on the NBRoutingPeer
+			Expect(merged.NodeSelector).To(HaveKeyWithValue("role", "gateway"))
+			// Defaulted by the CRD
+			Expect(merged.Ephemeral).To(BeEquivalentTo(util.Ptr(false)))
+			// Unset
+			Expect(merged.Annotations).To(HaveKeyWithValue("team", "platform"))
+		})
+	})
+
+	Context("When filtering Node events", func() {
+		node := &corev1.Node{
+			Spec: corev1.NodeSpec{PodCIDR: "10.244.0.0/24", PodCIDRs: []string{"10.244.0.0/24"}},
+			Status: corev1.NodeStatus{
+				Addresses: []corev1.NodeAddress{{Type: corev1.NodeInternalIP, Address: "192.168.0.10"}},
+			},
+		}
+
+		It("should ignore status heartbeats", func() {
+			updated := node.DeepCopy()
+			updated.Status.Conditions = []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue, LastHeartbeatTime: metav1.Now()}}
+			Expect(nodeCIDRsChanged.Update(event.UpdateEvent{ObjectOld: node, ObjectNew: updated})).To(BeFalse())
+		})
+
+		It("should pass pod CIDR and internal IP changes", func() {
+			updated := node.DeepCopy()
+			updated.Spec.PodCIDRs = []string{"10.244.0.0/24", "fd00::/64"}
+			Expect(nodeCIDRsChanged.Update(event.UpdateEvent{ObjectOld: node, ObjectNew: updated})).To(BeTrue())
+
+			updated = node.DeepCopy()
+			updated.Status.Addresses[0].Address = "192.168.0.11"
+			Expect(nodeCIDRsChanged.Update(event.UpdateEvent{ObjectOld: node, ObjectNew: updated})).To(BeTrue())
+		})
+	})
 })
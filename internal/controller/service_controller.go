@@ -3,6 +3,9 @@ package controller
 import (
 	"context"
 	"fmt"
+	"maps"
+	"net/netip"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -11,23 +14,35 @@ import (
 	netbirdiov1 "github.com/netbirdio/kubernetes-operator/api/v1"
 	"github.com/netbirdio/kubernetes-operator/internal/util"
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
 // ServiceReconciler reconciles a Service object
 type ServiceReconciler struct {
 	client.Client
-	Scheme              *runtime.Scheme
-	ClusterName         string
-	ClusterDNS          string
-	NamespacedNetworks  bool
-	ControllerNamespace string
-	DefaultLabels       map[string]string
+	Scheme                *runtime.Scheme
+	ClusterName           string
+	ClusterDNS            string
+	NamespacedNetworks    bool
+	ControllerNamespace   string
+	DefaultLabels         map[string]string
+	DefaultRouterName     string
+	ManageNetworkPolicies bool
+	RoutingPeerTemplate   string
+	AddressType           string
 }
 
 const (
@@ -40,6 +55,28 @@ const (
 	serviceProtocolAnnotation           = "netbird.io/policy-protocol"
 	servicePolicySourceGroupsAnnotation = "netbird.io/policy-source-groups"
 	servicePolicyNameAnnotation         = "netbird.io/policy-name"
+	serviceRouterAnnotation             = "netbird.io/router"
+	serviceNetworkAnnotation            = "netbird.io/network"
+	servicePodsAnnotation               = "netbird.io/expose-pods"
+	serviceAddressTypeAnnotation        = "netbird.io/address-type"
+	serviceDescriptionAnnotation        = "netbird.io/resource-description"
+	serviceEnabledAnnotation            = "netbird.io/enabled"
+
+	// ServiceAddressTypeDNS Services are exposed by their cluster DNS name
+	ServiceAddressTypeDNS = "DNS"
+	// ServiceAddressTypeClusterIP Services are exposed by their ClusterIPs
+	ServiceAddressTypeClusterIP = "ClusterIP"
+
+	// serviceLabel NBResource label referencing the Service a per-target NBResource was created for
+	serviceLabel = "netbird.io/service"
+	// serviceUIDLabel NBResource label referencing the UID of the Service a per-target NBResource was created for
+	serviceUIDLabel = "netbird.io/service-uid"
+
+	// ServiceLoadBalancerClass loadBalancerClass of LoadBalancer Services exposed through NetBird
+	ServiceLoadBalancerClass = "netbird.io/netbird"
+
+	// networkPolicyRouterLabel egress NetworkPolicy label referencing the NBRoutingPeer whose pods it selects
+	networkPolicyRouterLabel = "netbird.io/router"
 )
 
 var (
@@ -67,10 +104,16 @@ func (r *ServiceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 		return ctrl.Result{}, nil
 	}
 
-	_, shouldExpose := svc.Annotations[ServiceExposeAnnotation]
+	exposures, err := serviceExposures(ctx, r.Client, svc, logger)
+	if err != nil {
+		logger.Error(errKubernetesAPI, "error listing NBExposures", "err", err)
+		return ctrl.Result{}, err
+	}
+	// Annotations are only read from here on, Service updates are patches leaving them untouched
+	svc.Annotations = exposureAnnotations(svc.Annotations, exposures)
 
 	// If Service is being deleted, un-expose
-	shouldExpose = shouldExpose && svc.DeletionTimestamp == nil
+	shouldExpose := ServiceExposed(svc) && svc.DeletionTimestamp == nil
 
 	if shouldExpose {
 		return r.exposeService(ctx, req, svc, logger)
@@ -97,69 +140,88 @@ func (r *ServiceReconciler) hideService(ctx context.Context, req ctrl.Request, s
 	}
 
 	if util.Contains(svc.Finalizers, "netbird.io/cleanup") {
+		err = syncNBResources(ctx, r.Client, req.Namespace, serviceTargetLabels(svc), nil, logger)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	if r.ManageNetworkPolicies && util.Contains(svc.Finalizers, "netbird.io/cleanup") {
+		err = r.handleEgressPolicies(ctx, svc.Namespace, nil, logger)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	if util.Contains(svc.Finalizers, "netbird.io/cleanup") {
+		patch := client.MergeFrom(svc.DeepCopy())
 		svc.Finalizers = util.Without(svc.Finalizers, "netbird.io/cleanup")
-		err := r.Client.Update(ctx, &svc)
+		err := r.Client.Patch(ctx, &svc, patch)
 		if err != nil {
 			logger.Error(errKubernetesAPI, "error updating Service", "err", err)
 			return ctrl.Result{}, err
 		}
 	}
 
+	if svc.DeletionTimestamp == nil {
+		err = r.updateLoadBalancerStatus(ctx, svc, nil, logger)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
 	return ctrl.Result{}, nil
 }
 
 // exposeService creates/updates NBResource for Service
 func (r *ServiceReconciler) exposeService(ctx context.Context, req ctrl.Request, svc corev1.Service, logger logr.Logger) (ctrl.Result, error) {
-	routerNamespace := r.ControllerNamespace
-	if r.NamespacedNetworks {
-		routerNamespace = req.Namespace
-	}
+	routerNamespace := r.routerNamespace(req.Namespace)
 
 	if !util.Contains(svc.Finalizers, "netbird.io/cleanup") {
+		patch := client.MergeFrom(svc.DeepCopy())
 		svc.Finalizers = append(svc.Finalizers, "netbird.io/cleanup")
-		err := r.Client.Update(ctx, &svc)
+		err := r.Client.Patch(ctx, &svc, patch)
 		if err != nil {
 			logger.Error(errKubernetesAPI, "error updating Service", "err", err)
 			return ctrl.Result{}, err
 		}
 	}
 
-	var routingPeer netbirdiov1.NBRoutingPeer
-	// Check if NBRoutingPeer exists
-	err := r.Client.Get(ctx, types.NamespacedName{Namespace: routerNamespace, Name: "router"}, &routingPeer)
-	if err != nil && !errors.IsNotFound(err) {
-		logger.Error(errKubernetesAPI, "error getting NBRoutingPeer", "err", err)
-		return ctrl.Result{}, err
-	}
-
-	// Create NBRoutingPeer with default values if not exists
-	if errors.IsNotFound(err) {
-		routingPeer = netbirdiov1.NBRoutingPeer{
-			ObjectMeta: v1.ObjectMeta{
-				Name:       "router",
-				Namespace:  routerNamespace,
-				Finalizers: []string{"netbird.io/cleanup"},
-				Labels:     r.DefaultLabels,
-			},
-			Spec: netbirdiov1.NBRoutingPeerSpec{},
-		}
-
-		err = r.Client.Create(ctx, &routingPeer)
-		if err != nil {
-			logger.Error(errKubernetesAPI, "error creating NBRoutingPeer", "err", err)
-			return ctrl.Result{}, err
-		}
+	defaultRouterName := r.defaultRouterName()
+	routerRef := routingPeerRef(svc.Annotations, routerNamespace, defaultRouterName)
 
-		logger.Info("Network not available")
-		// Requeue to make sure network is created
-		return ctrl.Result{RequeueAfter: 5 * time.Second}, nil
+	nbrp, result, err := ensureRoutingPeer(ctx, r.Client, routerRef, types.NamespacedName{Namespace: routerNamespace, Name: defaultRouterName}, r.DefaultLabels, r.RoutingPeerTemplate, logger)
+	if nbrp == nil {
+		return result, err
 	}
+	routingPeer := *nbrp
 
 	if routingPeer.Status.NetworkID == nil {
 		logger.Info("Network not available")
 		return ctrl.Result{RequeueAfter: 5 * time.Second}, nil
 	}
 
+	if ServiceExposedPerPod(svc) {
+		return r.exposePods(ctx, req, svc, routingPeer, logger)
+	}
+
+	targets, err := r.externalTargets(ctx, svc, logger)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if len(targets) == 0 && r.addressType(svc) == ServiceAddressTypeClusterIP {
+		targets = clusterIPTargets(svc, logger)
+	}
+	if len(targets) > 1 {
+		return r.exposeTargets(ctx, req, svc, routingPeer, targets, logger)
+	}
+
+	// Remove per-target NBResources if Service was previously exposed through multiple NBResources
+	err = syncNBResources(ctx, r.Client, req.Namespace, serviceTargetLabels(svc), nil, logger)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
 	var nbResource netbirdiov1.NBResource
 	err = r.Client.Get(ctx, req.NamespacedName, &nbResource)
 	if err != nil && !errors.IsNotFound(err) {
@@ -172,6 +234,10 @@ func (r *ServiceReconciler) exposeService(ctx context.Context, req ctrl.Request,
 	if nbrsErr != nil {
 		return ctrl.Result{}, nbrsErr
 	}
+	if len(targets) == 1 {
+		// Publish the real target instead of the cluster DNS name
+		nbResource.Spec.Address = targets[0].Address
+	}
 
 	if errors.IsNotFound(err) {
 		err = r.Client.Create(ctx, &nbResource)
@@ -187,19 +253,321 @@ func (r *ServiceReconciler) exposeService(ctx context.Context, req ctrl.Request,
 		}
 	}
 
+	if r.ManageNetworkPolicies {
+		err = r.handleEgressPolicies(ctx, svc.Namespace, &types.NamespacedName{Namespace: routingPeer.Namespace, Name: routingPeer.Name}, logger)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	var addresses []string
+	if serviceLoadBalancer(svc) {
+		if nbResource.Status.NetworkResourceID == nil {
+			logger.Info("NBResource not ready")
+			return ctrl.Result{RequeueAfter: 5 * time.Second}, nil
+		}
+		addresses = []string{nbResource.Spec.Address}
+	}
+
+	err = r.updateLoadBalancerStatus(ctx, svc, addresses, logger)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
 	return ctrl.Result{}, nil
 }
 
-// reconcileNBResource ensures NBResource settings are in-line with Service definition and annotations
-func (r *ServiceReconciler) reconcileNBResource(nbResource *netbirdiov1.NBResource, req ctrl.Request, svc corev1.Service, routingPeer netbirdiov1.NBRoutingPeer, logger logr.Logger) error {
-	groups := []string{fmt.Sprintf("%s-%s-%s", r.ClusterName, req.Namespace, req.Name)}
-	if v, ok := svc.Annotations[serviceGroupsAnnotation]; ok {
-		groups = nil
-		for _, g := range strings.Split(v, ",") {
-			groups = append(groups, strings.TrimSpace(g))
+// serviceTarget NBResource target of a Service exposed through multiple NBResources
+type serviceTarget struct {
+	// Suffix appended to Service NBResource names
+	Suffix string
+	// Address NetBird resource address
+	Address string
+}
+
+// exposePods creates/updates NBResource per ready endpoint of Service, addressed by the endpoint hostname
+func (r *ServiceReconciler) exposePods(ctx context.Context, req ctrl.Request, svc corev1.Service, routingPeer netbirdiov1.NBRoutingPeer, logger logr.Logger) (ctrl.Result, error) {
+	endpoints, err := r.readyEndpoints(ctx, svc, logger)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	var targets []serviceTarget
+	for _, endpoint := range endpoints {
+		if endpoint.Hostname == nil || *endpoint.Hostname == "" {
+			logger.Info("Endpoint has no hostname, skipping", "addresses", endpoint.Addresses)
+			continue
+		}
+		if slices.ContainsFunc(targets, func(t serviceTarget) bool { return t.Suffix == *endpoint.Hostname }) {
+			continue
+		}
+		targets = append(targets, serviceTarget{
+			Suffix:  *endpoint.Hostname,
+			Address: fmt.Sprintf("%s.%s.%s.%s", *endpoint.Hostname, svc.Name, svc.Namespace, r.ClusterDNS),
+		})
+	}
+
+	return r.exposeTargets(ctx, req, svc, routingPeer, targets, logger)
+}
+
+// exposeTargets creates/updates NBResource per target of Service, replacing the Service NBResource
+func (r *ServiceReconciler) exposeTargets(ctx context.Context, req ctrl.Request, svc corev1.Service, routingPeer netbirdiov1.NBRoutingPeer, targets []serviceTarget, logger logr.Logger) (ctrl.Result, error) {
+	var nbResource netbirdiov1.NBResource
+	err := r.Client.Get(ctx, req.NamespacedName, &nbResource)
+	if err != nil && !errors.IsNotFound(err) {
+		logger.Error(errKubernetesAPI, "error getting NBResource", "err", err)
+		return ctrl.Result{}, err
+	}
+
+	// Remove Service NBResource if Service was previously exposed as a whole
+	if err == nil && nbResource.DeletionTimestamp == nil {
+		err = r.Client.Delete(ctx, &nbResource)
+		if err != nil && !errors.IsNotFound(err) {
+			logger.Error(errKubernetesAPI, "error deleting NBResource", "err", err)
+			return ctrl.Result{}, err
+		}
+	}
+
+	var desired []netbirdiov1.NBResource
+	for _, target := range targets {
+		var targetResource netbirdiov1.NBResource
+		err = r.reconcileNBResource(&targetResource, req, svc, routingPeer, logger)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		labels := maps.Clone(r.DefaultLabels)
+		if labels == nil {
+			labels = make(map[string]string)
+		}
+		maps.Copy(labels, serviceTargetLabels(svc))
+
+		targetResource.ObjectMeta.Name = serviceTargetName(req.Name, target.Suffix)
+		targetResource.ObjectMeta.Labels = labels
+		targetResource.Spec.Name = fmt.Sprintf("%s-%s", targetResource.Spec.Name, target.Suffix)
+		targetResource.Spec.Address = target.Address
+		desired = append(desired, targetResource)
+	}
+
+	err = syncNBResources(ctx, r.Client, req.Namespace, serviceTargetLabels(svc), desired, logger)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if r.ManageNetworkPolicies {
+		err = r.handleEgressPolicies(ctx, svc.Namespace, &types.NamespacedName{Namespace: routingPeer.Namespace, Name: routingPeer.Name}, logger)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	var addresses []string
+	if serviceLoadBalancer(svc) {
+		// Publish every target, e.g. both ClusterIPs of dual-stack Services, once all of them are exposed
+		for _, targetResource := range desired {
+			var current netbirdiov1.NBResource
+			err = r.Client.Get(ctx, types.NamespacedName{Namespace: req.Namespace, Name: targetResource.Name}, &current)
+			if err != nil && !errors.IsNotFound(err) {
+				logger.Error(errKubernetesAPI, "error getting NBResource", "err", err)
+				return ctrl.Result{}, err
+			}
+			if err != nil || current.Status.NetworkResourceID == nil {
+				logger.Info("NBResource not ready", "name", targetResource.Name)
+				return ctrl.Result{RequeueAfter: 5 * time.Second}, nil
+			}
+			addresses = append(addresses, targetResource.Spec.Address)
 		}
 	}
 
+	err = r.updateLoadBalancerStatus(ctx, svc, addresses, logger)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// externalTargets targets outside the cluster reached by ExternalName Services and Services with manual endpoints,
+// nil for Services with pod backends
+func (r *ServiceReconciler) externalTargets(ctx context.Context, svc corev1.Service, logger logr.Logger) ([]serviceTarget, error) {
+	if svc.Spec.Type == corev1.ServiceTypeExternalName {
+		return []serviceTarget{{Suffix: "external", Address: svc.Spec.ExternalName}}, nil
+	}
+	if len(svc.Spec.Selector) > 0 {
+		return nil, nil
+	}
+
+	endpoints, err := r.readyEndpoints(ctx, svc, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	var targets []serviceTarget
+	for _, endpoint := range endpoints {
+		if endpoint.TargetRef != nil && endpoint.TargetRef.Kind == "Pod" {
+			// Manually managed EndpointSlices pointing at pods are still reachable through the Service
+			return nil, nil
+		}
+		for _, address := range endpoint.Addresses {
+			target, ok := ipTarget(address)
+			if !ok {
+				logger.Info("Invalid endpoint address, skipping", "address", address)
+				continue
+			}
+			if !slices.Contains(targets, target) {
+				targets = append(targets, target)
+			}
+		}
+	}
+	return targets, nil
+}
+
+// serviceTargetLabels labels selecting the per-target NBResources of Service
+// Selecting by UID as well keeps a recreated Service from adopting NBResources of its predecessor
+func serviceTargetLabels(svc corev1.Service) map[string]string {
+	return map[string]string{
+		serviceLabel:    svc.Name,
+		serviceUIDLabel: string(svc.UID),
+	}
+}
+
+// serviceTargetName NBResource name for a target of Service
+// A hash of Service name and suffix keeps names unique where `<svc>-<suffix>` is ambiguous,
+// e.g. Service "a-b" with target "c" and Service "a" with target "b-c"
+func serviceTargetName(svcName, suffix string) string {
+	return hashedResourceName(svcName+"-"+suffix, svcName+"/"+suffix)
+}
+
+// clusterIPTargets ClusterIPs of Service, one per IP family
+func clusterIPTargets(svc corev1.Service, logger logr.Logger) []serviceTarget {
+	clusterIPs := svc.Spec.ClusterIPs
+	if len(clusterIPs) == 0 && svc.Spec.ClusterIP != "" {
+		clusterIPs = []string{svc.Spec.ClusterIP}
+	}
+
+	var targets []serviceTarget
+	for _, clusterIP := range clusterIPs {
+		if clusterIP == corev1.ClusterIPNone {
+			logger.Info("Headless Service has no ClusterIP, exposing cluster DNS name")
+			return nil
+		}
+		target, ok := ipTarget(clusterIP)
+		if !ok {
+			logger.Info("Invalid ClusterIP, skipping", "address", clusterIP)
+			continue
+		}
+		targets = append(targets, target)
+	}
+	return targets
+}
+
+// ipTarget target exposing a single IP address as a host prefix
+func ipTarget(address string) (serviceTarget, bool) {
+	ip, err := netip.ParseAddr(address)
+	if err != nil {
+		return serviceTarget{}, false
+	}
+	return serviceTarget{
+		Suffix:  strings.NewReplacer(".", "-", ":", "-").Replace(ip.String()),
+		Address: netip.PrefixFrom(ip, ip.BitLen()).String(),
+	}, true
+}
+
+// addressType address type of Service from netbird.io/address-type annotation, defaults to the operator address type
+func (r *ServiceReconciler) addressType(svc corev1.Service) string {
+	addressType := r.AddressType
+	if v, ok := svc.Annotations[serviceAddressTypeAnnotation]; ok {
+		addressType = v
+	}
+	if strings.EqualFold(addressType, ServiceAddressTypeClusterIP) {
+		return ServiceAddressTypeClusterIP
+	}
+	return ServiceAddressTypeDNS
+}
+
+// readyEndpoints ready endpoints of EndpointSlices of Service
+func (r *ServiceReconciler) readyEndpoints(ctx context.Context, svc corev1.Service, logger logr.Logger) ([]discoveryv1.Endpoint, error) {
+	var endpointSlices discoveryv1.EndpointSliceList
+	err := r.Client.List(ctx, &endpointSlices, client.InNamespace(svc.Namespace), client.MatchingLabels{discoveryv1.LabelServiceName: svc.Name})
+	if err != nil {
+		logger.Error(errKubernetesAPI, "error listing EndpointSlices", "err", err)
+		return nil, err
+	}
+
+	var endpoints []discoveryv1.Endpoint
+	for _, endpointSlice := range endpointSlices.Items {
+		for _, endpoint := range endpointSlice.Endpoints {
+			if endpoint.Conditions.Ready != nil && !*endpoint.Conditions.Ready {
+				continue
+			}
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+	return endpoints, nil
+}
+
+// endpointSliceService map func enqueueing the Service of an EndpointSlice
+func (r *ServiceReconciler) endpointSliceService(ctx context.Context, obj client.Object) []reconcile.Request {
+	serviceName, ok := obj.GetLabels()[discoveryv1.LabelServiceName]
+	if !ok {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: obj.GetNamespace(), Name: serviceName}}}
+}
+
+// updateLoadBalancerStatus sets the load balancer ingress of Services to addresses (hostnames, or the IPs of
+// single-address prefixes), clearing it if empty, e.g. once a Service is no longer a NetBird LoadBalancer
+func (r *ServiceReconciler) updateLoadBalancerStatus(ctx context.Context, svc corev1.Service, addresses []string, logger logr.Logger) error {
+	if svc.Spec.Type == corev1.ServiceTypeLoadBalancer && !serviceLoadBalancer(svc) {
+		// Load balancer status of other LoadBalancer Services is owned by their load balancer controller
+		return nil
+	}
+
+	var ingress []corev1.LoadBalancerIngress
+	for _, address := range addresses {
+		if prefix, err := netip.ParsePrefix(address); err == nil {
+			ingress = append(ingress, corev1.LoadBalancerIngress{IP: prefix.Addr().String()})
+		} else {
+			ingress = append(ingress, corev1.LoadBalancerIngress{Hostname: address})
+		}
+	}
+	if equality.Semantic.DeepEqual(svc.Status.LoadBalancer.Ingress, ingress) {
+		return nil
+	}
+
+	patch := client.MergeFrom(svc.DeepCopy())
+	svc.Status.LoadBalancer.Ingress = ingress
+	err := r.Client.Status().Patch(ctx, &svc, patch)
+	if err != nil {
+		logger.Error(errKubernetesAPI, "error updating Service status", "err", err)
+		return err
+	}
+	return nil
+}
+
+// ServiceExposed whether Service is exposed through netbird.io/expose annotation or NetBird loadBalancerClass
+func ServiceExposed(svc corev1.Service) bool {
+	_, ok := svc.Annotations[ServiceExposeAnnotation]
+	return ok || serviceLoadBalancer(svc)
+}
+
+// ServiceExposedPerPod whether Service is exposed per pod through netbird.io/expose-pods annotation
+func ServiceExposedPerPod(svc corev1.Service) bool {
+	v, ok := svc.Annotations[servicePodsAnnotation]
+	return ok && v != "false"
+}
+
+// serviceLoadBalancer whether Service is a LoadBalancer Service of NetBird loadBalancerClass
+func serviceLoadBalancer(svc corev1.Service) bool {
+	return svc.Spec.Type == corev1.ServiceTypeLoadBalancer &&
+		svc.Spec.LoadBalancerClass != nil &&
+		*svc.Spec.LoadBalancerClass == ServiceLoadBalancerClass
+}
+
+// reconcileNBResource ensures NBResource settings are in-line with Service definition and annotations
+func (r *ServiceReconciler) reconcileNBResource(nbResource *netbirdiov1.NBResource, req ctrl.Request, svc corev1.Service, routingPeer netbirdiov1.NBRoutingPeer, logger logr.Logger) error {
+	groups := resourceGroups(svc.Annotations, fmt.Sprintf("%s-%s-%s", r.ClusterName, req.Namespace, req.Name))
+
 	resourceName := fmt.Sprintf("%s-%s", req.Namespace, req.Name)
 	if v, ok := svc.Annotations[serviceResourceAnnotation]; ok {
 		resourceName = v
@@ -210,9 +578,23 @@ func (r *ServiceReconciler) reconcileNBResource(nbResource *netbirdiov1.NBResour
 	nbResource.ObjectMeta.Labels = r.DefaultLabels
 	nbResource.Finalizers = []string{"netbird.io/cleanup"}
 	nbResource.Spec.Name = resourceName
-	nbResource.Spec.NetworkID = *routingPeer.Status.NetworkID
+	// NBResourceReconciler moves the network resource when the Service moves to another routing peer network
+	nbResource.Spec.NetworkID = ""
+	nbResource.Spec.NetworkRef = &netbirdiov1.NBResourceNetworkRef{
+		Namespace: routingPeer.Namespace,
+		Name:      routingPeer.Name,
+	}
 	nbResource.Spec.Address = fmt.Sprintf("%s.%s.%s", svc.Name, svc.Namespace, r.ClusterDNS)
 	nbResource.Spec.Groups = groups
+	nbResource.Spec.Description = ""
+	if v, ok := svc.Annotations[serviceDescriptionAnnotation]; ok {
+		nbResource.Spec.Description = v
+	} else if r.addressType(svc) == ServiceAddressTypeClusterIP {
+		// Record the DNS name of Services exposed by ClusterIP
+		nbResource.Spec.Description = nbResource.Spec.Address
+	}
+
+	nbResource.Spec.Enabled = resourceEnabled(svc.Annotations)
 
 	if _, ok := svc.Annotations[servicePolicyAnnotation]; ok {
 		err := r.applyPolicy(nbResource, svc, logger)
@@ -230,65 +612,525 @@ func (r *ServiceReconciler) reconcileNBResource(nbResource *netbirdiov1.NBResour
 
 func (r *ServiceReconciler) applyPolicy(nbResource *netbirdiov1.NBResource, svc corev1.Service, logger logr.Logger) error {
 	nbResource.Spec.PolicyName = svc.Annotations[servicePolicyAnnotation]
+	filterProtocols, filterPorts, err := policyFilters(svc.Annotations)
+	if err != nil {
+		return err
+	}
+
+	applyPolicySources(nbResource, svc.Annotations, logger)
+
+	for _, p := range svc.Spec.Ports {
+		switch p.Protocol {
+		case corev1.ProtocolTCP:
+			if (len(filterPorts) > 0 && !util.Contains(filterPorts, p.Port)) || (len(filterProtocols) > 0 && !util.Contains(filterProtocols, "tcp")) {
+				if util.Contains(nbResource.Spec.TCPPorts, p.Port) {
+					nbResource.Spec.TCPPorts = util.Without(nbResource.Spec.TCPPorts, p.Port)
+				}
+				continue
+			}
+			if !util.Contains(nbResource.Spec.TCPPorts, p.Port) {
+				nbResource.Spec.TCPPorts = append(nbResource.Spec.TCPPorts, p.Port)
+			}
+		case corev1.ProtocolUDP:
+			if (len(filterPorts) > 0 && !util.Contains(filterPorts, p.Port)) || (len(filterProtocols) > 0 && !util.Contains(filterProtocols, "udp")) {
+				if util.Contains(nbResource.Spec.UDPPorts, p.Port) {
+					nbResource.Spec.UDPPorts = util.Without(nbResource.Spec.UDPPorts, p.Port)
+				}
+				continue
+			}
+			if !util.Contains(nbResource.Spec.UDPPorts, p.Port) {
+				nbResource.Spec.UDPPorts = append(nbResource.Spec.UDPPorts, p.Port)
+			}
+		default:
+			logger.Info("Unsupported protocol %v", p.Protocol)
+			continue
+		}
+	}
+
+	return nil
+}
+
+// policyFilters protocols and ports policies are narrowed down to from netbird.io/policy-protocol and netbird.io/policy-ports annotations
+func policyFilters(annotations map[string]string) ([]string, []int32, error) {
 	var filterProtocols []string
-	if v, ok := svc.Annotations[serviceProtocolAnnotation]; ok {
+	if v, ok := annotations[serviceProtocolAnnotation]; ok {
+		if v != "tcp" && v != "udp" {
+			return nil, nil, fmt.Errorf("%s must be tcp or udp, got %q", serviceProtocolAnnotation, v)
+		}
 		filterProtocols = []string{v}
 	}
 	var filterPorts []int32
-	if v, ok := svc.Annotations[servicePortsAnnotation]; ok {
+	if v, ok := annotations[servicePortsAnnotation]; ok {
 		for _, v := range strings.Split(v, ",") {
 			port, err := strconv.ParseInt(v, 10, 64)
 			if err != nil {
-				return err
+				return nil, nil, fmt.Errorf("%s must be a comma-separated list of ports: %w", servicePortsAnnotation, err)
+			}
+			if port < 0 || port > 65535 {
+				return nil, nil, fmt.Errorf("%s port %d out of range 0-65535", servicePortsAnnotation, port)
 			}
 
 			filterPorts = append(filterPorts, int32(port))
 		}
 	}
+	return filterProtocols, filterPorts, nil
+}
 
-	if v, ok := svc.Annotations[servicePolicySourceGroupsAnnotation]; ok {
+// resourceGroups groups of NBResources from netbird.io/groups annotation, defaults to defaultGroup
+func resourceGroups(annotations map[string]string, defaultGroup string) []string {
+	v, ok := annotations[serviceGroupsAnnotation]
+	if !ok {
+		return []string{defaultGroup}
+	}
+
+	var groups []string
+	for _, g := range strings.Split(v, ",") {
+		groups = append(groups, strings.TrimSpace(g))
+	}
+	return groups
+}
+
+// applyPolicySources sets policy source groups and friendly names of NBResources from annotations
+func applyPolicySources(nbResource *netbirdiov1.NBResource, annotations map[string]string, logger logr.Logger) {
+	if v, ok := annotations[servicePolicySourceGroupsAnnotation]; ok {
 		nbResource.Spec.PolicySourceGroups = util.SplitTrim(v, ",")
 	} else {
 		nbResource.Spec.PolicySourceGroups = nil
 	}
 
-	policyFriendlyNameList := util.SplitTrim(svc.Annotations[servicePolicyNameAnnotation], ",")
-	nbResource.Spec.PolicyFriendlyName = make(map[string]string)
-	for _, v := range policyFriendlyNameList {
+	var invalid []string
+	nbResource.Spec.PolicyFriendlyName, invalid = policyFriendlyNames(annotations)
+	for _, v := range invalid {
+		logger.Info("Invalid number of : found in annotation", "annotation", servicePolicyNameAnnotation, "value", v)
+	}
+}
+
+// policyFriendlyNames friendly names of policies from netbird.io/policy-name annotation, along with invalid entries
+func policyFriendlyNames(annotations map[string]string) (map[string]string, []string) {
+	friendlyNames := make(map[string]string)
+	var invalid []string
+	for _, v := range util.SplitTrim(annotations[servicePolicyNameAnnotation], ",") {
 		friendlyNameMap := util.SplitTrim(v, ":")
 		if len(friendlyNameMap) != 2 {
-			logger.Info("Invalid number of : found in annotation", "annotation", servicePolicyNameAnnotation, "value", v)
+			invalid = append(invalid, v)
 			continue
 		}
-		nbResource.Spec.PolicyFriendlyName[friendlyNameMap[0]] = friendlyNameMap[1]
+		friendlyNames[friendlyNameMap[0]] = friendlyNameMap[1]
 	}
+	return friendlyNames, invalid
+}
 
-	for _, p := range svc.Spec.Ports {
-		switch p.Protocol {
-		case corev1.ProtocolTCP:
-			if (len(filterPorts) > 0 && !util.Contains(filterPorts, p.Port)) || (len(filterProtocols) > 0 && !util.Contains(filterProtocols, "tcp")) {
-				if util.Contains(nbResource.Spec.TCPPorts, p.Port) {
-					nbResource.Spec.TCPPorts = util.Without(nbResource.Spec.TCPPorts, p.Port)
-				}
-				continue
+// ServicePolicies NBPolicies and policy source groups selected by netbird.io/policy and netbird.io/policy-source-groups annotations
+func ServicePolicies(annotations map[string]string) ([]string, []string) {
+	return util.SplitTrim(annotations[servicePolicyAnnotation], ","), util.SplitTrim(annotations[servicePolicySourceGroupsAnnotation], ",")
+}
+
+// ValidateServiceAnnotations validates netbird.io annotations the way they are read when exposing Services
+func ValidateServiceAnnotations(annotations map[string]string) error {
+	var problems []string
+
+	if _, _, err := policyFilters(annotations); err != nil {
+		problems = append(problems, err.Error())
+	}
+
+	if _, invalid := policyFriendlyNames(annotations); len(invalid) > 0 {
+		problems = append(problems, fmt.Sprintf("%s entries must be policy:friendly-name, got %q", servicePolicyNameAnnotation, invalid))
+	}
+
+	if v, ok := annotations[serviceGroupsAnnotation]; ok && slices.Contains(resourceGroups(annotations, ""), "") {
+		problems = append(problems, fmt.Sprintf("%s contains an empty group name: %q", serviceGroupsAnnotation, v))
+	}
+
+	if v, ok := annotations[serviceNetworkAnnotation]; ok {
+		if ref := routingPeerRef(annotations, "", ""); ref.Name == "" || strings.Count(v, "/") > 1 {
+			problems = append(problems, fmt.Sprintf("%s must be <namespace>/<name> or <name>, got %q", serviceNetworkAnnotation, v))
+		}
+	}
+
+	if v, ok := annotations[serviceAddressTypeAnnotation]; ok && !strings.EqualFold(v, ServiceAddressTypeDNS) && !strings.EqualFold(v, ServiceAddressTypeClusterIP) {
+		problems = append(problems, fmt.Sprintf("%s must be %s or %s, got %q", serviceAddressTypeAnnotation, ServiceAddressTypeDNS, ServiceAddressTypeClusterIP, v))
+	}
+
+	if v, ok := annotations[serviceEnabledAnnotation]; ok {
+		if _, err := strconv.ParseBool(strings.TrimSpace(v)); err != nil {
+			problems = append(problems, fmt.Sprintf("%s must be true or false, got %q", serviceEnabledAnnotation, v))
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("invalid NetBird annotations: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// resourceEnabled Enabled setting of NBResources from netbird.io/enabled annotation, nil (enabled) if unset or invalid
+func resourceEnabled(annotations map[string]string) *bool {
+	v, ok := annotations[serviceEnabledAnnotation]
+	if !ok {
+		return nil
+	}
+	enabled, err := strconv.ParseBool(strings.TrimSpace(v))
+	if err != nil {
+		return nil
+	}
+	return &enabled
+}
+
+// routingPeerRef NBRoutingPeer selected by netbird.io/network and netbird.io/router annotations,
+// defaults to defaultRouterName in routerNamespace
+func routingPeerRef(annotations map[string]string, routerNamespace, defaultRouterName string) types.NamespacedName {
+	ref := types.NamespacedName{Namespace: routerNamespace, Name: defaultRouterName}
+	if v, ok := annotations[serviceRouterAnnotation]; ok && strings.TrimSpace(v) != "" {
+		ref.Name = strings.TrimSpace(v)
+	}
+	// netbird.io/network selects an NBRoutingPeer in any namespace, overriding netbird.io/router
+	if v, ok := annotations[serviceNetworkAnnotation]; ok && strings.TrimSpace(v) != "" {
+		namespace, name, found := strings.Cut(strings.TrimSpace(v), "/")
+		if found {
+			ref.Namespace = strings.TrimSpace(namespace)
+			ref.Name = strings.TrimSpace(name)
+		} else {
+			ref.Name = namespace
+		}
+	}
+	return ref
+}
+
+// ensureRoutingPeer gets the NBRoutingPeer referenced by routerRef, creating it with default values if it's the
+// default routing peer. Returns a nil NBRoutingPeer with the result to return while it's not available.
+func ensureRoutingPeer(ctx context.Context, c client.Client, routerRef, defaultRef types.NamespacedName, defaultLabels map[string]string, routingPeerTemplate string, logger logr.Logger) (*netbirdiov1.NBRoutingPeer, ctrl.Result, error) {
+	var routingPeer netbirdiov1.NBRoutingPeer
+	// Check if NBRoutingPeer exists
+	err := c.Get(ctx, routerRef, &routingPeer)
+	if err == nil {
+		return &routingPeer, ctrl.Result{}, nil
+	}
+	if !errors.IsNotFound(err) {
+		logger.Error(errKubernetesAPI, "error getting NBRoutingPeer", "err", err)
+		return nil, ctrl.Result{}, err
+	}
+
+	// Routing peers selected by annotation are not created implicitly
+	if routerRef != defaultRef {
+		logger.Info("NBRoutingPeer not found", "namespace", routerRef.Namespace, "name", routerRef.Name)
+		return nil, ctrl.Result{RequeueAfter: defaultRequeueAfter}, nil
+	}
+
+	// Create NBRoutingPeer with default values if not exists
+	labels := maps.Clone(defaultLabels)
+	if routingPeerTemplate != "" {
+		if labels == nil {
+			labels = make(map[string]string)
+		}
+		// Spec is synced from the template by NBRoutingPeerReconciler
+		labels[netbirdiov1.NBRoutingPeerTemplateLabel] = routingPeerTemplate
+	}
+
+	routingPeer = netbirdiov1.NBRoutingPeer{
+		ObjectMeta: v1.ObjectMeta{
+			Name:       routerRef.Name,
+			Namespace:  routerRef.Namespace,
+			Finalizers: []string{"netbird.io/cleanup"},
+			Labels:     labels,
+		},
+		Spec: netbirdiov1.NBRoutingPeerSpec{},
+	}
+
+	err = c.Create(ctx, &routingPeer)
+	if err != nil {
+		logger.Error(errKubernetesAPI, "error creating NBRoutingPeer", "err", err)
+		return nil, ctrl.Result{}, err
+	}
+
+	logger.Info("Network not available")
+	// Requeue to make sure network is created
+	return nil, ctrl.Result{RequeueAfter: 5 * time.Second}, nil
+}
+
+// routerNamespace namespace of NBRoutingPeers routing traffic to Services in namespace
+func (r *ServiceReconciler) routerNamespace(namespace string) string {
+	if r.NamespacedNetworks {
+		return namespace
+	}
+	return r.ControllerNamespace
+}
+
+// defaultRouterName name of NBRoutingPeer routing Services without netbird.io/router or netbird.io/network annotations
+func (r *ServiceReconciler) defaultRouterName() string {
+	if r.DefaultRouterName == "" {
+		return "router"
+	}
+	return r.DefaultRouterName
+}
+
+// handleEgressPolicies updates egress NetworkPolicy of routing peer routerRef, and of routing peers allowed to reach
+// Service backends in namespace, e.g. after a Service moved to another routing peer
+func (r *ServiceReconciler) handleEgressPolicies(ctx context.Context, namespace string, routerRef *types.NamespacedName, logger logr.Logger) error {
+	var networkPolicies networkingv1.NetworkPolicyList
+	err := r.Client.List(ctx, &networkPolicies, client.HasLabels{networkPolicyRouterLabel})
+	if err != nil {
+		logger.Error(errKubernetesAPI, "error listing NetworkPolicy", "err", err)
+		return err
+	}
+
+	var routers []types.NamespacedName
+	if routerRef != nil {
+		routers = append(routers, *routerRef)
+	}
+	for _, np := range networkPolicies.Items {
+		router := types.NamespacedName{Namespace: np.Namespace, Name: np.Labels[networkPolicyRouterLabel]}
+		if slices.Contains(routers, router) || !egressPolicyReaches(np, namespace) {
+			continue
+		}
+		routers = append(routers, router)
+	}
+
+	for _, router := range routers {
+		err = r.handleEgressPolicy(ctx, router, logger)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// egressPolicyReaches whether egress NetworkPolicy allows traffic to pods in namespace
+func egressPolicyReaches(np networkingv1.NetworkPolicy, namespace string) bool {
+	for _, rule := range np.Spec.Egress {
+		for _, peer := range rule.To {
+			if peer.NamespaceSelector != nil && peer.NamespaceSelector.MatchLabels["kubernetes.io/metadata.name"] == namespace {
+				return true
 			}
-			if !util.Contains(nbResource.Spec.TCPPorts, p.Port) {
-				nbResource.Spec.TCPPorts = append(nbResource.Spec.TCPPorts, p.Port)
+		}
+	}
+	return false
+}
+
+// routedServices exposed Services routed through NBRoutingPeer router
+func (r *ServiceReconciler) routedServices(ctx context.Context, router types.NamespacedName, logger logr.Logger) ([]corev1.Service, error) {
+	var services corev1.ServiceList
+	err := r.Client.List(ctx, &services)
+	if err != nil {
+		logger.Error(errKubernetesAPI, "error listing Services", "err", err)
+		return nil, err
+	}
+
+	var routed []corev1.Service
+	for _, svc := range services.Items {
+		// Exposed Services carry the cleanup finalizer, skip matching NBExposures for all others
+		if svc.DeletionTimestamp != nil || !util.Contains(svc.Finalizers, "netbird.io/cleanup") {
+			continue
+		}
+		exposures, err := serviceExposures(ctx, r.Client, svc, logger)
+		if err != nil {
+			logger.Error(errKubernetesAPI, "error listing NBExposures", "err", err)
+			return nil, err
+		}
+		svc.Annotations = exposureAnnotations(svc.Annotations, exposures)
+		if !ServiceExposed(svc) || routingPeerRef(svc.Annotations, r.routerNamespace(svc.Namespace), r.defaultRouterName()) != router {
+			continue
+		}
+		routed = append(routed, svc)
+	}
+
+	slices.SortFunc(routed, func(a, b corev1.Service) int {
+		return strings.Compare(a.Namespace+"/"+a.Name, b.Namespace+"/"+b.Name)
+	})
+	return routed, nil
+}
+
+// routingPeerServices enqueues Services routed through NBRoutingPeer to update its egress NetworkPolicy
+func (r *ServiceReconciler) routingPeerServices(ctx context.Context, obj client.Object) []reconcile.Request {
+	services, err := r.routedServices(ctx, types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}, ctrl.Log.WithName("Service"))
+	if err != nil {
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(services))
+	for _, svc := range services {
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: svc.Namespace, Name: svc.Name}})
+	}
+	return requests
+}
+
+// serviceBackendPorts target ports of Service, narrowed down the same way NetBird policy ports are
+func serviceBackendPorts(svc corev1.Service) ([]networkingv1.NetworkPolicyPort, error) {
+	var filterProtocols []string
+	var filterPorts []int32
+	if _, ok := svc.Annotations[servicePolicyAnnotation]; ok {
+		var err error
+		filterProtocols, filterPorts, err = policyFilters(svc.Annotations)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var ports []networkingv1.NetworkPolicyPort
+	for _, p := range svc.Spec.Ports {
+		if len(filterPorts) > 0 && !util.Contains(filterPorts, p.Port) {
+			continue
+		}
+		if len(filterProtocols) > 0 && !util.Contains(filterProtocols, strings.ToLower(string(p.Protocol))) {
+			continue
+		}
+		targetPort := p.TargetPort
+		if targetPort.IntValue() == 0 && targetPort.StrVal == "" {
+			targetPort = intstr.FromInt32(p.Port)
+		}
+		ports = append(ports, networkingv1.NetworkPolicyPort{
+			Protocol: util.Ptr(p.Protocol),
+			Port:     &targetPort,
+		})
+	}
+	return ports, nil
+}
+
+// handleEgressPolicy creates/updates NetworkPolicy restricting routing peer pods egress to backends of exposed Services,
+// DNS, and destinations outside the cluster pod network. Only routing peer pods are selected, Service backends stay
+// reachable by other clients.
+func (r *ServiceReconciler) handleEgressPolicy(ctx context.Context, router types.NamespacedName, logger logr.Logger) error {
+	var routingPeer netbirdiov1.NBRoutingPeer
+	err := r.Client.Get(ctx, router, &routingPeer)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			// Egress NetworkPolicy is garbage collected with its NBRoutingPeer
+			return nil
+		}
+		logger.Error(errKubernetesAPI, "error getting NBRoutingPeer", "err", err)
+		return err
+	}
+
+	services, err := r.routedServices(ctx, router, logger)
+	if err != nil {
+		return err
+	}
+
+	var podCIDRsV4, podCIDRsV6 []string
+	// Routing peers exposing cluster CIDRs route NetBird traffic to any pod, directly or through ClusterIPs
+	clusterCIDRs := routingPeer.Spec.ClusterCIDRs
+	if clusterCIDRs == nil || (!clusterCIDRs.Pods && !clusterCIDRs.Services) {
+		var nodeList corev1.NodeList
+		err = r.Client.List(ctx, &nodeList)
+		if err != nil {
+			logger.Error(errKubernetesAPI, "error listing Nodes", "err", err)
+			return err
+		}
+
+		for _, node := range nodeList.Items {
+			podCIDRs := node.Spec.PodCIDRs
+			if len(podCIDRs) == 0 && node.Spec.PodCIDR != "" {
+				podCIDRs = []string{node.Spec.PodCIDR}
 			}
-		case corev1.ProtocolUDP:
-			if (len(filterPorts) > 0 && !util.Contains(filterPorts, p.Port)) || (len(filterProtocols) > 0 && !util.Contains(filterProtocols, "udp")) {
-				if util.Contains(nbResource.Spec.UDPPorts, p.Port) {
-					nbResource.Spec.UDPPorts = util.Without(nbResource.Spec.UDPPorts, p.Port)
+			for _, cidr := range podCIDRs {
+				if strings.Contains(cidr, ":") {
+					podCIDRsV6 = append(podCIDRsV6, cidr)
+				} else {
+					podCIDRsV4 = append(podCIDRsV4, cidr)
 				}
-				continue
-			}
-			if !util.Contains(nbResource.Spec.UDPPorts, p.Port) {
-				nbResource.Spec.UDPPorts = append(nbResource.Spec.UDPPorts, p.Port)
 			}
-		default:
-			logger.Info("Unsupported protocol %v", p.Protocol)
+		}
+		slices.Sort(podCIDRsV4)
+		slices.Sort(podCIDRsV6)
+	}
+
+	dnsPorts := []networkingv1.NetworkPolicyPort{
+		{Protocol: util.Ptr(corev1.ProtocolUDP), Port: util.Ptr(intstr.FromInt32(53))},
+		{Protocol: util.Ptr(corev1.ProtocolTCP), Port: util.Ptr(intstr.FromInt32(53))},
+	}
+	egress := []networkingv1.NetworkPolicyEgressRule{
+		{
+			Ports: dnsPorts,
+		},
+		{
+			// NetBird management, signal, relays and remote peers
+			To: []networkingv1.NetworkPolicyPeer{
+				{IPBlock: &networkingv1.IPBlock{CIDR: "0.0.0.0/0", Except: podCIDRsV4}},
+				{IPBlock: &networkingv1.IPBlock{CIDR: "::/0", Except: podCIDRsV6}},
+			},
+		},
+	}
+
+	for _, svc := range services {
+		if len(svc.Spec.Selector) == 0 {
+			// Services without selector have no backend pods to select
 			continue
 		}
+		ports, err := serviceBackendPorts(svc)
+		if err != nil {
+			logger.Error(errInvalidValue, "invalid policy annotations, skipping Service", "service", svc.Namespace+"/"+svc.Name, "err", err)
+			continue
+		}
+		egress = append(egress, networkingv1.NetworkPolicyEgressRule{
+			To: []networkingv1.NetworkPolicyPeer{
+				{
+					NamespaceSelector: &v1.LabelSelector{
+						MatchLabels: map[string]string{"kubernetes.io/metadata.name": svc.Namespace},
+					},
+					PodSelector: &v1.LabelSelector{
+						MatchLabels: svc.Spec.Selector,
+					},
+				},
+			},
+			Ports: ports,
+		})
+	}
+
+	labels := maps.Clone(r.DefaultLabels)
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+	labels[networkPolicyRouterLabel] = routingPeer.Name
+
+	spec := networkingv1.NetworkPolicySpec{
+		PodSelector: v1.LabelSelector{
+			MatchLabels: routingPeerPodsSelector(&routingPeer),
+		},
+		PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeEgress},
+		Egress:      egress,
+	}
+
+	networkPolicy := networkingv1.NetworkPolicy{}
+	err = r.Client.Get(ctx, types.NamespacedName{Namespace: router.Namespace, Name: router.Name + "-egress"}, &networkPolicy)
+	if err != nil && !errors.IsNotFound(err) {
+		logger.Error(errKubernetesAPI, "error getting NetworkPolicy", "err", err)
+		return err
+	}
+
+	if errors.IsNotFound(err) {
+		networkPolicy = networkingv1.NetworkPolicy{
+			ObjectMeta: v1.ObjectMeta{
+				Name:      router.Name + "-egress",
+				Namespace: router.Namespace,
+				Labels:    labels,
+				OwnerReferences: []v1.OwnerReference{
+					{
+						APIVersion:         netbirdiov1.GroupVersion.Identifier(),
+						Kind:               "NBRoutingPeer",
+						Name:               routingPeer.Name,
+						UID:                routingPeer.UID,
+						BlockOwnerDeletion: util.Ptr(true),
+					},
+				},
+			},
+			Spec: spec,
+		}
+		err = r.Client.Create(ctx, &networkPolicy)
+		if err != nil {
+			logger.Error(errKubernetesAPI, "error creating NetworkPolicy", "err", err)
+			return err
+		}
+		return nil
+	}
+
+	if !equality.Semantic.DeepEqual(networkPolicy.Spec, spec) || !maps.Equal(networkPolicy.Labels, labels) {
+		networkPolicy.Labels = labels
+		networkPolicy.Spec = spec
+		err = r.Client.Update(ctx, &networkPolicy)
+		if err != nil {
+			logger.Error(errKubernetesAPI, "error updating NetworkPolicy", "err", err)
+			return err
+		}
 	}
 
 	return nil
@@ -296,8 +1138,15 @@ func (r *ServiceReconciler) applyPolicy(nbResource *netbirdiov1.NBResource, svc
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *ServiceReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		For(&corev1.Service{}).
-		Named("service").
-		Complete(r)
+		Watches(&discoveryv1.EndpointSlice{}, handler.EnqueueRequestsFromMapFunc(r.endpointSliceService)).
+		Watches(&netbirdiov1.NBExposure{}, handler.EnqueueRequestsFromMapFunc(r.exposedServices)).
+		Watches(&corev1.Namespace{}, handler.EnqueueRequestsFromMapFunc(r.namespaceServices), builder.WithPredicates(predicate.LabelChangedPredicate{})).
+		Named("service")
+	if r.ManageNetworkPolicies {
+		// Egress NetworkPolicies follow routing peer pod labels and cluster CIDRs
+		b = b.Watches(&netbirdiov1.NBRoutingPeer{}, handler.EnqueueRequestsFromMapFunc(r.routingPeerServices), builder.WithPredicates(predicate.GenerationChangedPredicate{}))
+	}
+	return b.Complete(r)
 }
@@ -6,10 +6,13 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
@@ -201,7 +204,8 @@ var _ = Describe("Service Controller", func() {
 							Expect(nbResource.Spec.Address).To(Equal(typeNamespacedName.Name + "." + typeNamespacedName.Namespace + "." + controllerReconciler.ClusterDNS))
 							Expect(nbResource.Spec.Groups).To(ConsistOf([]string{controllerReconciler.ClusterName + "-" + typeNamespacedName.Namespace + "-" + typeNamespacedName.Name}))
 							Expect(nbResource.Spec.Name).To(Equal(typeNamespacedName.Namespace + "-" + typeNamespacedName.Name))
-							Expect(nbResource.Spec.NetworkID).To(Equal(policyName))
+							Expect(nbResource.Spec.NetworkID).To(BeEmpty())
+							Expect(nbResource.Spec.NetworkRef).To(Equal(&netbirdiov1.NBResourceNetworkRef{Namespace: "default", Name: "router"}))
 							Expect(nbResource.Spec.PolicyName).To(BeEmpty())
 							Expect(nbResource.Spec.TCPPorts).To(BeEmpty())
 							Expect(nbResource.Spec.UDPPorts).To(BeEmpty())
@@ -272,6 +276,243 @@ var _ = Describe("Service Controller", func() {
 							Expect(nbResource.Spec.Name).To(Equal("meow"))
 						})
 					})
+					When("network policies are managed", func() {
+						BeforeEach(func() {
+							node := &corev1.Node{
+								ObjectMeta: v1.ObjectMeta{Name: "netpol-node"},
+								Spec:       corev1.NodeSpec{PodCIDR: "10.244.0.0/24"},
+							}
+							Expect(k8sClient.Create(ctx, node)).To(Succeed())
+							DeferCleanup(func() {
+								Expect(k8sClient.Delete(ctx, node)).To(Succeed())
+							})
+						})
+						It("should restrict routing peer traffic to Service backends", func() {
+							controllerReconciler.ManageNetworkPolicies = true
+							service.Spec.Selector = map[string]string{"app": "test"}
+							Expect(k8sClient.Update(ctx, service)).To(Succeed())
+
+							_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+								NamespacedName: typeNamespacedName,
+							})
+							Expect(err).NotTo(HaveOccurred())
+
+							// Service backends are not isolated
+							var networkPolicies networkingv1.NetworkPolicyList
+							Expect(k8sClient.List(ctx, &networkPolicies, client.InNamespace(typeNamespacedName.Namespace))).To(Succeed())
+							Expect(networkPolicies.Items).To(HaveLen(1))
+
+							egressPolicy := &networkingv1.NetworkPolicy{}
+							egressPolicyName := types.NamespacedName{Namespace: typeNamespacedName.Namespace, Name: "router-egress"}
+							Expect(k8sClient.Get(ctx, egressPolicyName, egressPolicy)).To(Succeed())
+							defer func() {
+								Expect(k8sClient.Delete(ctx, egressPolicy)).To(Succeed())
+							}()
+							Expect(egressPolicy.Labels).To(HaveKeyWithValue("netbird.io/router", "router"))
+							Expect(egressPolicy.Spec.PodSelector.MatchLabels).To(HaveKeyWithValue("app.kubernetes.io/instance", "router"))
+							Expect(egressPolicy.Spec.PolicyTypes).To(ConsistOf(networkingv1.PolicyTypeEgress))
+							// DNS, outside of cluster, and Service backends
+							Expect(egressPolicy.Spec.Egress).To(HaveLen(3))
+							Expect(egressPolicy.Spec.Egress[1].To[0].IPBlock.Except).To(ConsistOf("10.244.0.0/24"))
+							Expect(egressPolicy.Spec.Egress[2].To[0].PodSelector.MatchLabels).To(Equal(map[string]string{"app": "test"}))
+							Expect(egressPolicy.Spec.Egress[2].Ports).To(HaveLen(4))
+
+							By("un-exposing the Service")
+							Expect(k8sClient.Get(ctx, typeNamespacedName, service)).To(Succeed())
+							delete(service.Annotations, ServiceExposeAnnotation)
+							Expect(k8sClient.Update(ctx, service)).To(Succeed())
+
+							_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{
+								NamespacedName: typeNamespacedName,
+							})
+							Expect(err).NotTo(HaveOccurred())
+
+							Expect(k8sClient.Get(ctx, egressPolicyName, egressPolicy)).To(Succeed())
+							Expect(egressPolicy.Spec.Egress).To(HaveLen(2))
+						})
+						It("should restrict traffic of routing peers in other namespaces", func() {
+							routerNamespace := &corev1.Namespace{ObjectMeta: v1.ObjectMeta{Name: "netpol-router"}}
+							err := k8sClient.Create(ctx, routerNamespace)
+							if !errors.IsAlreadyExists(err) {
+								Expect(err).NotTo(HaveOccurred())
+							}
+							nbrp := &netbirdiov1.NBRoutingPeer{
+								ObjectMeta: v1.ObjectMeta{
+									Namespace: routerNamespace.Name,
+									Name:      "other",
+								},
+							}
+							Expect(k8sClient.Create(ctx, nbrp)).To(Succeed())
+							defer func() {
+								Expect(k8sClient.Delete(ctx, nbrp)).To(Succeed())
+							}()
+							nbrp.Status.NetworkID = util.Ptr("other-network")
+							Expect(k8sClient.Status().Update(ctx, nbrp)).To(Succeed())
+
+							controllerReconciler.ManageNetworkPolicies = true
+							service.Annotations[serviceNetworkAnnotation] = routerNamespace.Name + "/other"
+							service.Spec.Selector = map[string]string{"app": "test"}
+							Expect(k8sClient.Update(ctx, service)).To(Succeed())
+
+							_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{
+								NamespacedName: typeNamespacedName,
+							})
+							Expect(err).NotTo(HaveOccurred())
+
+							egressPolicy := &networkingv1.NetworkPolicy{}
+							egressPolicyName := types.NamespacedName{Namespace: routerNamespace.Name, Name: "other-egress"}
+							Expect(k8sClient.Get(ctx, egressPolicyName, egressPolicy)).To(Succeed())
+							defer func() {
+								Expect(k8sClient.Delete(ctx, egressPolicy)).To(Succeed())
+							}()
+							// DNS, outside of cluster, and Service backends
+							Expect(egressPolicy.Spec.Egress).To(HaveLen(3))
+							Expect(egressPolicy.Spec.Egress[2].To[0].NamespaceSelector.MatchLabels).To(HaveKeyWithValue("kubernetes.io/metadata.name", typeNamespacedName.Namespace))
+
+							By("moving the Service to the default routing peer")
+							Expect(k8sClient.Get(ctx, typeNamespacedName, service)).To(Succeed())
+							delete(service.Annotations, serviceNetworkAnnotation)
+							Expect(k8sClient.Update(ctx, service)).To(Succeed())
+
+							_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{
+								NamespacedName: typeNamespacedName,
+							})
+							Expect(err).NotTo(HaveOccurred())
+
+							Expect(k8sClient.Get(ctx, egressPolicyName, egressPolicy)).To(Succeed())
+							Expect(egressPolicy.Spec.Egress).To(HaveLen(2))
+							defaultEgressPolicy := &networkingv1.NetworkPolicy{}
+							Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: typeNamespacedName.Namespace, Name: "router-egress"}, defaultEgressPolicy)).To(Succeed())
+							defer func() {
+								Expect(k8sClient.Delete(ctx, defaultEgressPolicy)).To(Succeed())
+							}()
+							Expect(defaultEgressPolicy.Spec.Egress).To(HaveLen(3))
+
+							By("un-exposing the Service")
+							Expect(k8sClient.Get(ctx, typeNamespacedName, service)).To(Succeed())
+							delete(service.Annotations, ServiceExposeAnnotation)
+							Expect(k8sClient.Update(ctx, service)).To(Succeed())
+
+							_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{
+								NamespacedName: typeNamespacedName,
+							})
+							Expect(err).NotTo(HaveOccurred())
+
+							Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: typeNamespacedName.Namespace, Name: "router-egress"}, defaultEgressPolicy)).To(Succeed())
+							Expect(defaultEgressPolicy.Spec.Egress).To(HaveLen(2))
+						})
+						It("should allow routing peer traffic to pods when cluster CIDRs are exposed", func() {
+							nbrp := &netbirdiov1.NBRoutingPeer{}
+							Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: typeNamespacedName.Namespace, Name: "router"}, nbrp)).To(Succeed())
+							nbrp.Spec.ClusterCIDRs = &netbirdiov1.NBRoutingPeerClusterCIDRs{
+								Groups: []string{"cluster"},
+								Pods:   true,
+							}
+							Expect(k8sClient.Update(ctx, nbrp)).To(Succeed())
+
+							controllerReconciler.ManageNetworkPolicies = true
+							service.Spec.Selector = map[string]string{"app": "test"}
+							Expect(k8sClient.Update(ctx, service)).To(Succeed())
+
+							_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+								NamespacedName: typeNamespacedName,
+							})
+							Expect(err).NotTo(HaveOccurred())
+
+							egressPolicy := &networkingv1.NetworkPolicy{}
+							Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: typeNamespacedName.Namespace, Name: "router-egress"}, egressPolicy)).To(Succeed())
+							defer func() {
+								Expect(k8sClient.Delete(ctx, egressPolicy)).To(Succeed())
+							}()
+							// DNS, anywhere including pods, and Service backends
+							Expect(egressPolicy.Spec.Egress).To(HaveLen(3))
+							Expect(egressPolicy.Spec.Egress[1].To[0].IPBlock.Except).To(BeEmpty())
+							Expect(egressPolicy.Spec.Egress[2].To[0].PodSelector.MatchLabels).To(Equal(map[string]string{"app": "test"}))
+						})
+					})
+					When("router is specified", func() {
+						BeforeEach(func() {
+							service.Annotations[serviceRouterAnnotation] = "internal"
+							Expect(k8sClient.Update(ctx, service)).To(Succeed())
+						})
+						It("should wait for specified NBRoutingPeer without creating it", func() {
+							res, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+								NamespacedName: typeNamespacedName,
+							})
+							Expect(err).NotTo(HaveOccurred())
+							Expect(res.RequeueAfter).NotTo(BeZero())
+							nbrp := &netbirdiov1.NBRoutingPeer{}
+							Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: typeNamespacedName.Namespace, Name: "internal"}, nbrp)).NotTo(Succeed())
+							nbResource := &netbirdiov1.NBResource{}
+							Expect(k8sClient.Get(ctx, typeNamespacedName, nbResource)).NotTo(Succeed())
+						})
+						It("should create NBResource in specified NBRoutingPeer network", func() {
+							nbrp := &netbirdiov1.NBRoutingPeer{
+								ObjectMeta: v1.ObjectMeta{
+									Namespace: typeNamespacedName.Namespace,
+									Name:      "internal",
+								},
+								Spec: netbirdiov1.NBRoutingPeerSpec{},
+							}
+							Expect(k8sClient.Create(ctx, nbrp)).To(Succeed())
+							defer func() {
+								Expect(k8sClient.Delete(ctx, nbrp)).To(Succeed())
+							}()
+							nbrp.Status.NetworkID = util.Ptr("internal-network")
+							Expect(k8sClient.Status().Update(ctx, nbrp)).To(Succeed())
+
+							_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+								NamespacedName: typeNamespacedName,
+							})
+							Expect(err).NotTo(HaveOccurred())
+							nbResource := &netbirdiov1.NBResource{}
+							Expect(k8sClient.Get(ctx, typeNamespacedName, nbResource)).To(Succeed())
+							Expect(nbResource.Spec.NetworkRef).To(Equal(&netbirdiov1.NBResourceNetworkRef{Namespace: typeNamespacedName.Namespace, Name: "internal"}))
+						})
+					})
+					When("network is specified", func() {
+						BeforeEach(func() {
+							service.Annotations[serviceRouterAnnotation] = "internal"
+							service.Annotations[serviceNetworkAnnotation] = "kube-system/sensitive"
+							Expect(k8sClient.Update(ctx, service)).To(Succeed())
+						})
+						It("should create NBResource referencing NBRoutingPeer of another namespace", func() {
+							nbrp := &netbirdiov1.NBRoutingPeer{
+								ObjectMeta: v1.ObjectMeta{
+									Namespace: "kube-system",
+									Name:      "sensitive",
+								},
+								Spec: netbirdiov1.NBRoutingPeerSpec{},
+							}
+							Expect(k8sClient.Create(ctx, nbrp)).To(Succeed())
+							defer func() {
+								Expect(k8sClient.Delete(ctx, nbrp)).To(Succeed())
+							}()
+							nbrp.Status.NetworkID = util.Ptr("sensitive-network")
+							Expect(k8sClient.Status().Update(ctx, nbrp)).To(Succeed())
+
+							_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+								NamespacedName: typeNamespacedName,
+							})
+							Expect(err).NotTo(HaveOccurred())
+							nbResource := &netbirdiov1.NBResource{}
+							Expect(k8sClient.Get(ctx, typeNamespacedName, nbResource)).To(Succeed())
+							Expect(nbResource.Spec.NetworkRef).To(Equal(&netbirdiov1.NBResourceNetworkRef{Namespace: "kube-system", Name: "sensitive"}))
+						})
+					})
+					When("Service is disabled", func() {
+						It("should keep NBResource disabled", func() {
+							service.Annotations[serviceEnabledAnnotation] = "false"
+							Expect(k8sClient.Update(ctx, service)).To(Succeed())
+							_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+								NamespacedName: typeNamespacedName,
+							})
+							Expect(err).NotTo(HaveOccurred())
+							nbResource := &netbirdiov1.NBResource{}
+							Expect(k8sClient.Get(ctx, typeNamespacedName, nbResource)).To(Succeed())
+							Expect(nbResource.Spec.IsEnabled()).To(BeFalse())
+						})
+					})
 					When("resource groups specified", func() {
 						It("should create NBResource with specified groups", func() {
 							service.Annotations[serviceGroupsAnnotation] = "meow, wow ,test"
@@ -286,6 +527,290 @@ var _ = Describe("Service Controller", func() {
 						})
 					})
 				})
+				When("Service points outside the cluster", func() {
+					BeforeEach(func() {
+						if service.Annotations == nil {
+							service.Annotations = make(map[string]string)
+						}
+						service.Annotations[ServiceExposeAnnotation] = "true"
+						Expect(k8sClient.Update(ctx, service)).To(Succeed())
+					})
+
+					It("should expose the ClusterIP with ClusterIP address type", func() {
+						service.Annotations[serviceAddressTypeAnnotation] = ServiceAddressTypeClusterIP
+						service.Spec.Selector = map[string]string{"app": "test"}
+						Expect(k8sClient.Update(ctx, service)).To(Succeed())
+						Expect(service.Spec.ClusterIP).NotTo(BeEmpty())
+
+						_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+							NamespacedName: typeNamespacedName,
+						})
+						Expect(err).NotTo(HaveOccurred())
+						nbResource := &netbirdiov1.NBResource{}
+						Expect(k8sClient.Get(ctx, typeNamespacedName, nbResource)).To(Succeed())
+						Expect(nbResource.Spec.Address).To(Equal(service.Spec.ClusterIP + "/32"))
+						Expect(nbResource.Spec.Description).To(Equal(typeNamespacedName.Name + "." + typeNamespacedName.Namespace + "." + controllerReconciler.ClusterDNS))
+					})
+
+					It("should expose the externalName of ExternalName Services", func() {
+						service.Spec.Type = corev1.ServiceTypeExternalName
+						service.Spec.ExternalName = "db.example.com"
+						service.Spec.ClusterIP = ""
+						service.Spec.ClusterIPs = nil
+						Expect(k8sClient.Update(ctx, service)).To(Succeed())
+
+						_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+							NamespacedName: typeNamespacedName,
+						})
+						Expect(err).NotTo(HaveOccurred())
+						nbResource := &netbirdiov1.NBResource{}
+						Expect(k8sClient.Get(ctx, typeNamespacedName, nbResource)).To(Succeed())
+						Expect(nbResource.Spec.Address).To(Equal("db.example.com"))
+					})
+
+					It("should expose manual endpoint addresses", func() {
+						endpointSlice := &discoveryv1.EndpointSlice{
+							ObjectMeta: v1.ObjectMeta{
+								Name:      "test-resource-manual",
+								Namespace: typeNamespacedName.Namespace,
+								Labels:    map[string]string{discoveryv1.LabelServiceName: typeNamespacedName.Name},
+							},
+							AddressType: discoveryv1.AddressTypeIPv4,
+							Endpoints: []discoveryv1.Endpoint{
+								{Addresses: []string{"192.168.1.10"}},
+							},
+						}
+						Expect(k8sClient.Create(ctx, endpointSlice)).To(Succeed())
+						defer func() {
+							Expect(k8sClient.Delete(ctx, endpointSlice)).To(Succeed())
+						}()
+
+						_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+							NamespacedName: typeNamespacedName,
+						})
+						Expect(err).NotTo(HaveOccurred())
+						nbResource := &netbirdiov1.NBResource{}
+						Expect(k8sClient.Get(ctx, typeNamespacedName, nbResource)).To(Succeed())
+						Expect(nbResource.Spec.Address).To(Equal("192.168.1.10/32"))
+
+						endpointSlice.Endpoints = append(endpointSlice.Endpoints, discoveryv1.Endpoint{Addresses: []string{"192.168.1.11"}})
+						Expect(k8sClient.Update(ctx, endpointSlice)).To(Succeed())
+
+						_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{
+							NamespacedName: typeNamespacedName,
+						})
+						Expect(err).NotTo(HaveOccurred())
+						Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: typeNamespacedName.Namespace, Name: serviceTargetName(typeNamespacedName.Name, "192-168-1-11")}, nbResource)).To(Succeed())
+						Expect(nbResource.Spec.Address).To(Equal("192.168.1.11/32"))
+						Expect(nbResource.Spec.Groups).To(ConsistOf(controllerReconciler.ClusterName + "-" + typeNamespacedName.Namespace + "-" + typeNamespacedName.Name))
+
+						var nbResourceList netbirdiov1.NBResourceList
+						Expect(k8sClient.List(ctx, &nbResourceList, client.InNamespace(typeNamespacedName.Namespace), client.MatchingLabels{serviceLabel: typeNamespacedName.Name})).To(Succeed())
+						Expect(nbResourceList.Items).To(HaveLen(2))
+						for _, nbResource := range nbResourceList.Items {
+							nbResource.Finalizers = nil
+							Expect(k8sClient.Update(ctx, &nbResource)).To(Succeed())
+							Expect(k8sClient.Delete(ctx, &nbResource)).To(Succeed())
+						}
+					})
+				})
+				When("Service is exposed per pod", func() {
+					var endpointSlice *discoveryv1.EndpointSlice
+
+					BeforeEach(func() {
+						if service.Annotations == nil {
+							service.Annotations = make(map[string]string)
+						}
+						service.Annotations[ServiceExposeAnnotation] = "true"
+						service.Annotations[servicePodsAnnotation] = "true"
+						Expect(k8sClient.Update(ctx, service)).To(Succeed())
+
+						endpointSlice = &discoveryv1.EndpointSlice{
+							ObjectMeta: v1.ObjectMeta{
+								Name:      "test-resource-pods",
+								Namespace: typeNamespacedName.Namespace,
+								Labels:    map[string]string{discoveryv1.LabelServiceName: typeNamespacedName.Name},
+							},
+							AddressType: discoveryv1.AddressTypeIPv4,
+							Endpoints: []discoveryv1.Endpoint{
+								{
+									Addresses:  []string{"10.0.0.1"},
+									Hostname:   util.Ptr("db-0"),
+									Conditions: discoveryv1.EndpointConditions{Ready: util.Ptr(true)},
+								},
+								{
+									Addresses:  []string{"10.0.0.2"},
+									Hostname:   util.Ptr("db-1"),
+									Conditions: discoveryv1.EndpointConditions{Ready: util.Ptr(false)},
+								},
+							},
+						}
+						Expect(k8sClient.Create(ctx, endpointSlice)).To(Succeed())
+					})
+
+					AfterEach(func() {
+						Expect(k8sClient.Delete(ctx, endpointSlice)).To(Succeed())
+
+						var nbResourceList netbirdiov1.NBResourceList
+						Expect(k8sClient.List(ctx, &nbResourceList, client.InNamespace(typeNamespacedName.Namespace), client.MatchingLabels{serviceLabel: typeNamespacedName.Name})).To(Succeed())
+						for _, nbResource := range nbResourceList.Items {
+							nbResource.Finalizers = nil
+							Expect(k8sClient.Update(ctx, &nbResource)).To(Succeed())
+							err := k8sClient.Delete(ctx, &nbResource)
+							if !errors.IsNotFound(err) {
+								Expect(err).NotTo(HaveOccurred())
+							}
+						}
+					})
+
+					It("should create NBResource per ready pod", func() {
+						_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+							NamespacedName: typeNamespacedName,
+						})
+						Expect(err).NotTo(HaveOccurred())
+
+						nbResource := &netbirdiov1.NBResource{}
+						Expect(k8sClient.Get(ctx, typeNamespacedName, nbResource)).NotTo(Succeed())
+						Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: typeNamespacedName.Namespace, Name: serviceTargetName(typeNamespacedName.Name, "db-0")}, nbResource)).To(Succeed())
+						Expect(nbResource.Spec.Address).To(Equal("db-0." + typeNamespacedName.Name + "." + typeNamespacedName.Namespace + "." + controllerReconciler.ClusterDNS))
+						Expect(nbResource.Spec.Name).To(Equal(typeNamespacedName.Namespace + "-" + typeNamespacedName.Name + "-db-0"))
+						Expect(nbResource.Labels).To(HaveKeyWithValue(serviceLabel, typeNamespacedName.Name))
+						Expect(nbResource.Labels).To(HaveKeyWithValue(serviceUIDLabel, string(service.UID)))
+						Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: typeNamespacedName.Namespace, Name: serviceTargetName(typeNamespacedName.Name, "db-1")}, nbResource)).NotTo(Succeed())
+					})
+
+					It("should follow pods as they become ready or go away", func() {
+						_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+							NamespacedName: typeNamespacedName,
+						})
+						Expect(err).NotTo(HaveOccurred())
+
+						endpointSlice.Endpoints = endpointSlice.Endpoints[1:]
+						endpointSlice.Endpoints[0].Conditions.Ready = util.Ptr(true)
+						Expect(k8sClient.Update(ctx, endpointSlice)).To(Succeed())
+
+						_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{
+							NamespacedName: typeNamespacedName,
+						})
+						Expect(err).NotTo(HaveOccurred())
+
+						nbResource := &netbirdiov1.NBResource{}
+						Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: typeNamespacedName.Namespace, Name: serviceTargetName(typeNamespacedName.Name, "db-1")}, nbResource)).To(Succeed())
+						Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: typeNamespacedName.Namespace, Name: serviceTargetName(typeNamespacedName.Name, "db-0")}, nbResource)).To(Succeed())
+						Expect(nbResource.DeletionTimestamp).NotTo(BeNil())
+					})
+				})
+				When("Service is a NetBird LoadBalancer", func() {
+					BeforeEach(func() {
+						service.Spec.Type = corev1.ServiceTypeLoadBalancer
+						service.Spec.LoadBalancerClass = util.Ptr(ServiceLoadBalancerClass)
+						Expect(k8sClient.Update(ctx, service)).To(Succeed())
+					})
+					It("should create NBResource and publish its address once ready", func() {
+						result, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+							NamespacedName: typeNamespacedName,
+						})
+						Expect(err).NotTo(HaveOccurred())
+						Expect(result.RequeueAfter).NotTo(BeZero())
+
+						nbResource := &netbirdiov1.NBResource{}
+						Expect(k8sClient.Get(ctx, typeNamespacedName, nbResource)).To(Succeed())
+						Expect(k8sClient.Get(ctx, typeNamespacedName, service)).To(Succeed())
+						Expect(service.Status.LoadBalancer.Ingress).To(BeEmpty())
+
+						nbResource.Status.NetworkResourceID = util.Ptr("resource")
+						Expect(k8sClient.Status().Update(ctx, nbResource)).To(Succeed())
+
+						result, err = controllerReconciler.Reconcile(ctx, reconcile.Request{
+							NamespacedName: typeNamespacedName,
+						})
+						Expect(err).NotTo(HaveOccurred())
+						Expect(result.RequeueAfter).To(BeZero())
+						Expect(k8sClient.Get(ctx, typeNamespacedName, service)).To(Succeed())
+						Expect(service.Status.LoadBalancer.Ingress).To(Equal([]corev1.LoadBalancerIngress{
+							{Hostname: nbResource.Spec.Address},
+						}))
+					})
+					It("should publish every address of Services exposed through multiple NBResources", func() {
+						endpointSlice := &discoveryv1.EndpointSlice{
+							ObjectMeta: v1.ObjectMeta{
+								Name:      "test-resource-lb",
+								Namespace: typeNamespacedName.Namespace,
+								Labels:    map[string]string{discoveryv1.LabelServiceName: typeNamespacedName.Name},
+							},
+							AddressType: discoveryv1.AddressTypeIPv4,
+							Endpoints: []discoveryv1.Endpoint{
+								{Addresses: []string{"192.168.1.10", "192.168.1.11"}},
+							},
+						}
+						Expect(k8sClient.Create(ctx, endpointSlice)).To(Succeed())
+						defer func() {
+							Expect(k8sClient.Delete(ctx, endpointSlice)).To(Succeed())
+						}()
+
+						result, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+							NamespacedName: typeNamespacedName,
+						})
+						Expect(err).NotTo(HaveOccurred())
+						Expect(result.RequeueAfter).NotTo(BeZero())
+						Expect(k8sClient.Get(ctx, typeNamespacedName, service)).To(Succeed())
+						Expect(service.Status.LoadBalancer.Ingress).To(BeEmpty())
+
+						for _, name := range []string{serviceTargetName(typeNamespacedName.Name, "192-168-1-10"), serviceTargetName(typeNamespacedName.Name, "192-168-1-11")} {
+							nbResource := &netbirdiov1.NBResource{}
+							Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: typeNamespacedName.Namespace, Name: name}, nbResource)).To(Succeed())
+							nbResource.Status.NetworkResourceID = util.Ptr(name)
+							Expect(k8sClient.Status().Update(ctx, nbResource)).To(Succeed())
+						}
+
+						result, err = controllerReconciler.Reconcile(ctx, reconcile.Request{
+							NamespacedName: typeNamespacedName,
+						})
+						Expect(err).NotTo(HaveOccurred())
+						Expect(result.RequeueAfter).To(BeZero())
+						Expect(k8sClient.Get(ctx, typeNamespacedName, service)).To(Succeed())
+						Expect(service.Status.LoadBalancer.Ingress).To(ConsistOf(
+							corev1.LoadBalancerIngress{IP: "192.168.1.10"},
+							corev1.LoadBalancerIngress{IP: "192.168.1.11"},
+						))
+					})
+					It("should clear published ClusterIPs once Service is no longer a NetBird LoadBalancer", func() {
+						service.Annotations[serviceAddressTypeAnnotation] = ServiceAddressTypeClusterIP
+						Expect(k8sClient.Update(ctx, service)).To(Succeed())
+
+						_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+							NamespacedName: typeNamespacedName,
+						})
+						Expect(err).NotTo(HaveOccurred())
+
+						nbResource := &netbirdiov1.NBResource{}
+						Expect(k8sClient.Get(ctx, typeNamespacedName, nbResource)).To(Succeed())
+						nbResource.Status.NetworkResourceID = util.Ptr("resource")
+						Expect(k8sClient.Status().Update(ctx, nbResource)).To(Succeed())
+
+						_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{
+							NamespacedName: typeNamespacedName,
+						})
+						Expect(err).NotTo(HaveOccurred())
+						Expect(k8sClient.Get(ctx, typeNamespacedName, service)).To(Succeed())
+						Expect(service.Status.LoadBalancer.Ingress).To(Equal([]corev1.LoadBalancerIngress{
+							{IP: service.Spec.ClusterIP},
+						}))
+
+						By("un-exposing the Service")
+						delete(service.Annotations, ServiceExposeAnnotation)
+						service.Spec.Type = corev1.ServiceTypeClusterIP
+						service.Spec.LoadBalancerClass = nil
+						Expect(k8sClient.Update(ctx, service)).To(Succeed())
+
+						_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{
+							NamespacedName: typeNamespacedName,
+						})
+						Expect(err).NotTo(HaveOccurred())
+						Expect(k8sClient.Get(ctx, typeNamespacedName, service)).To(Succeed())
+						Expect(service.Status.LoadBalancer.Ingress).To(BeEmpty())
+					})
+				})
 			})
 		})
 		When("Service is already exposed", func() {
@@ -515,4 +1040,11 @@ var _ = Describe("Service Controller", func() {
 			})
 		})
 	})
+
+	Context("When naming per-target NBResources", func() {
+		It("should not collide across Services", func() {
+			Expect(serviceTargetName("a-b", "c")).NotTo(Equal(serviceTargetName("a", "b-c")))
+			Expect(serviceTargetName("test", "db-0")).To(HavePrefix("test-db-0-"))
+		})
+	})
 })
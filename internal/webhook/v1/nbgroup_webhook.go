@@ -54,7 +54,7 @@ func (v *NBGroupCustomValidator) ValidateDelete(ctx context.Context, obj runtime
 	nbgrouplog.Info("Validation for NBGroup upon deletion", "name", nbgroup.GetName())
 
 	for _, o := range nbgroup.OwnerReferences {
-		if o.Kind == (&netbirdiov1.NBResource{}).Kind {
+		if o.Kind == "NBResource" {
 			var nbResource netbirdiov1.NBResource
 			err := v.client.Get(ctx, types.NamespacedName{Namespace: nbgroup.Namespace, Name: o.Name}, &nbResource)
 			if err != nil && !errors.IsNotFound(err) {
@@ -64,7 +64,17 @@ func (v *NBGroupCustomValidator) ValidateDelete(ctx context.Context, obj runtime
 				return nil, fmt.Errorf("group attached to NBResource %s/%s", nbgroup.Namespace, o.Name)
 			}
 		}
-		if o.Kind == (&netbirdiov1.NBRoutingPeer{}).Kind {
+		if o.Kind == "NBRoute" {
+			var nbRoute netbirdiov1.NBRoute
+			err := v.client.Get(ctx, types.NamespacedName{Namespace: nbgroup.Namespace, Name: o.Name}, &nbRoute)
+			if err != nil && !errors.IsNotFound(err) {
+				return nil, err
+			}
+			if err == nil && nbRoute.DeletionTimestamp == nil {
+				return nil, fmt.Errorf("group attached to NBRoute %s/%s", nbgroup.Namespace, o.Name)
+			}
+		}
+		if o.Kind == "NBRoutingPeer" {
 			var nbResource netbirdiov1.NBRoutingPeer
 			err := v.client.Get(ctx, types.NamespacedName{Namespace: nbgroup.Namespace, Name: o.Name}, &nbResource)
 			if err != nil && !errors.IsNotFound(err) {
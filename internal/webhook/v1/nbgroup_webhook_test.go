@@ -93,7 +93,7 @@ var _ = Describe("NBGroup Webhook", func() {
 						OwnerReferences: []v1.OwnerReference{
 							{
 								APIVersion: netbirdiov1.GroupVersion.Identifier(),
-								Kind:       nbResource.Kind,
+								Kind:       "NBResource",
 								Name:       nbResource.Name,
 								UID:        nbResource.UID,
 							},
@@ -120,6 +120,57 @@ var _ = Describe("NBGroup Webhook", func() {
 				Expect(validator.ValidateDelete(ctx, obj)).Error().To(HaveOccurred())
 			})
 		})
+		When("NBRoute owner exists", func() {
+			BeforeEach(func() {
+				nbRoute := &netbirdiov1.NBRoute{
+					ObjectMeta: v1.ObjectMeta{
+						Name:      "isexist",
+						Namespace: "default",
+					},
+					Spec: netbirdiov1.NBRouteSpec{
+						NetworkIdentifier: "test1",
+						Network:           "10.0.0.0/16",
+						PeerGroups:        []string{"test"},
+						Groups:            []string{"test"},
+					},
+				}
+
+				Expect(k8sClient.Create(ctx, nbRoute)).To(Succeed())
+
+				obj = &netbirdiov1.NBGroup{
+					ObjectMeta: v1.ObjectMeta{
+						Name:      "test",
+						Namespace: "default",
+						OwnerReferences: []v1.OwnerReference{
+							{
+								APIVersion: netbirdiov1.GroupVersion.Identifier(),
+								Kind:       "NBRoute",
+								Name:       nbRoute.Name,
+								UID:        nbRoute.UID,
+							},
+						},
+					},
+				}
+			})
+			AfterEach(func() {
+				nbRoute := &netbirdiov1.NBRoute{}
+				err := k8sClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "isexist"}, nbRoute)
+				if !errors.IsNotFound(err) {
+					Expect(err).NotTo(HaveOccurred())
+					if len(nbRoute.Finalizers) > 0 {
+						nbRoute.Finalizers = nil
+						Expect(k8sClient.Update(ctx, nbRoute)).To(Succeed())
+					}
+					err = k8sClient.Delete(ctx, nbRoute)
+					if !errors.IsNotFound(err) {
+						Expect(err).NotTo(HaveOccurred())
+					}
+				}
+			})
+			It("should deny deletion", func() {
+				Expect(validator.ValidateDelete(ctx, obj)).Error().To(HaveOccurred())
+			})
+		})
 		When("NBRoutingPeer owner exists", func() {
 			BeforeEach(func() {
 				nbrp := &netbirdiov1.NBRoutingPeer{
@@ -139,7 +190,7 @@ var _ = Describe("NBGroup Webhook", func() {
 						OwnerReferences: []v1.OwnerReference{
 							{
 								APIVersion: netbirdiov1.GroupVersion.Identifier(),
-								Kind:       nbrp.Kind,
+								Kind:       "NBRoutingPeer",
 								Name:       nbrp.Name,
 								UID:        nbrp.UID,
 							},
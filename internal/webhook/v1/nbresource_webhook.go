@@ -64,8 +64,8 @@ func (v *NBResourceCustomValidator) ValidateDelete(ctx context.Context, obj runt
 		return nil, err
 	}
 
-	if _, ok := svc.Annotations[controller.ServiceExposeAnnotation]; ok && svc.DeletionTimestamp == nil {
-		return nil, fmt.Errorf("service %s/%s still has netbird.io/expose annotation", svc.Namespace, svc.Name)
+	if controller.ServiceExposed(svc) && !controller.ServiceExposedPerPod(svc) && svc.DeletionTimestamp == nil {
+		return nil, fmt.Errorf("service %s/%s is still exposed through netbird.io/expose annotation or %s loadBalancerClass", svc.Namespace, svc.Name, controller.ServiceLoadBalancerClass)
 	}
 
 	return nil, nil
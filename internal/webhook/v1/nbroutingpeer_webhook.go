@@ -35,12 +35,24 @@ var _ webhook.CustomValidator = &NBRoutingPeerCustomValidator{}
 
 // ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type NBRoutingPeer.
 func (v *NBRoutingPeerCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
-	return nil, nil
+	nbroutingpeer, ok := obj.(*netbirdiov1.NBRoutingPeer)
+	if !ok {
+		return nil, fmt.Errorf("expected a NBRoutingPeer object but got %T", obj)
+	}
+	nbroutingpeerlog.Info("Validation for NBRoutingPeer upon creation", "name", nbroutingpeer.GetName())
+
+	return nil, validateUnhealthyAction(nbroutingpeer.Spec)
 }
 
 // ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type NBRoutingPeer.
 func (v *NBRoutingPeerCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
-	return nil, nil
+	nbroutingpeer, ok := newObj.(*netbirdiov1.NBRoutingPeer)
+	if !ok {
+		return nil, fmt.Errorf("expected a NBRoutingPeer object but got %T", newObj)
+	}
+	nbroutingpeerlog.Info("Validation for NBRoutingPeer upon update", "name", nbroutingpeer.GetName())
+
+	return nil, validateUnhealthyAction(nbroutingpeer.Spec)
 }
 
 // ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type NBRoutingPeer.
@@ -51,7 +63,8 @@ func (v *NBRoutingPeerCustomValidator) ValidateDelete(ctx context.Context, obj r
 	}
 	nbroutingpeerlog.Info("Validation for NBRoutingPeer upon deletion", "name", nbroutingpeer.GetName())
 
-	if nbroutingpeer.Status.NetworkID == nil {
+	// Per-zone routing peers don't own the network, resources are kept for remaining zones
+	if nbroutingpeer.Status.NetworkID == nil || nbroutingpeer.Labels[netbirdiov1.NBRoutingPeerTopologyLabel] != "" {
 		return nil, nil
 	}
 
@@ -64,7 +77,7 @@ func (v *NBRoutingPeerCustomValidator) ValidateDelete(ctx context.Context, obj r
 	resourceValidator := &NBResourceCustomValidator{client: v.client}
 
 	for _, r := range nbResources.Items {
-		if r.Spec.NetworkID == *nbroutingpeer.Status.NetworkID {
+		if r.ResolvedNetworkID() == *nbroutingpeer.Status.NetworkID {
 			_, err = resourceValidator.ValidateDelete(ctx, &r)
 			if err != nil {
 				return nil, err
@@ -74,3 +87,25 @@ func (v *NBRoutingPeerCustomValidator) ValidateDelete(ctx context.Context, obj r
 
 	return nil, nil
 }
+
+// validateUnhealthyAction RaiseMetric needs healthy network routers below the maximum metric to have any effect
+func validateUnhealthyAction(spec netbirdiov1.NBRoutingPeerSpec) error {
+	if spec.UnhealthyAction != netbirdiov1.NBRoutingPeerUnhealthyRaiseMetric {
+		return nil
+	}
+
+	// Per-zone network routers use the zone metric instead
+	if spec.Topology != nil {
+		for _, zone := range spec.Topology.Zones {
+			if zone.Metric == 0 || zone.Metric >= netbirdiov1.NBRouterMaxMetric {
+				return fmt.Errorf("unhealthyAction RaiseMetric requires zone %s metric lower than %d", zone.Name, netbirdiov1.NBRouterMaxMetric)
+			}
+		}
+		return nil
+	}
+
+	if spec.RouterMetric == 0 || spec.RouterMetric >= netbirdiov1.NBRouterMaxMetric {
+		return fmt.Errorf("unhealthyAction RaiseMetric requires routerMetric lower than %d", netbirdiov1.NBRouterMaxMetric)
+	}
+	return nil
+}
@@ -35,6 +35,28 @@ var _ = Describe("NBRoutingPeer Webhook", func() {
 		It("should allow update", func() {
 			Expect(validator.ValidateUpdate(ctx, oldObj, obj)).Error().NotTo(HaveOccurred())
 		})
+		It("should deny RaiseMetric without a lower router metric", func() {
+			obj.Spec.UnhealthyAction = netbirdiov1.NBRoutingPeerUnhealthyRaiseMetric
+			obj.Spec.RouterMetric = netbirdiov1.NBRouterMaxMetric
+			Expect(validator.ValidateCreate(ctx, obj)).Error().To(HaveOccurred())
+			Expect(validator.ValidateUpdate(ctx, oldObj, obj)).Error().To(HaveOccurred())
+
+			obj.Spec.RouterMetric = 100
+			Expect(validator.ValidateCreate(ctx, obj)).Error().NotTo(HaveOccurred())
+		})
+		It("should deny RaiseMetric without lower zone metrics", func() {
+			obj.Spec.UnhealthyAction = netbirdiov1.NBRoutingPeerUnhealthyRaiseMetric
+			obj.Spec.Topology = &netbirdiov1.NBRoutingPeerTopology{
+				Zones: []netbirdiov1.NBRoutingPeerZone{
+					{Name: "a", Metric: 100},
+					{Name: "b", Metric: netbirdiov1.NBRouterMaxMetric},
+				},
+			}
+			Expect(validator.ValidateCreate(ctx, obj)).Error().To(HaveOccurred())
+
+			obj.Spec.Topology.Zones[1].Metric = 200
+			Expect(validator.ValidateCreate(ctx, obj)).Error().NotTo(HaveOccurred())
+		})
 		When("No NBResources Exist", func() {
 			It("should allow deletion", func() {
 				Expect(validator.ValidateDelete(ctx, obj)).Error().NotTo(HaveOccurred())
@@ -0,0 +1,119 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	netbirdiov1 "github.com/netbirdio/kubernetes-operator/api/v1"
+	"github.com/netbirdio/kubernetes-operator/internal/controller"
+)
+
+// nolint:unused
+// log is for logging in this package.
+var servicelog = logf.Log.WithName("service-resource")
+
+// SetupServiceWebhookWithManager registers the webhook for Service in the manager.
+func SetupServiceWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&corev1.Service{}).
+		WithValidator(&ServiceCustomValidator{client: mgr.GetClient()}).
+		Complete()
+}
+
+// ServiceCustomValidator struct is responsible for validating NetBird annotations of Services
+// when they are created or updated.
+type ServiceCustomValidator struct {
+	client client.Client
+}
+
+var _ webhook.CustomValidator = &ServiceCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type Service.
+func (v *ServiceCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	svc, ok := obj.(*corev1.Service)
+	if !ok {
+		return nil, fmt.Errorf("expected a Service object but got %T", obj)
+	}
+
+	if len(netbirdAnnotations(svc.Annotations)) == 0 {
+		return nil, nil
+	}
+	servicelog.Info("Validation for Service upon creation", "namespace", svc.Namespace, "name", svc.Name)
+
+	return v.validateAnnotations(ctx, svc)
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type Service.
+func (v *ServiceCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldSvc, ok := oldObj.(*corev1.Service)
+	if !ok {
+		return nil, fmt.Errorf("expected a Service object but got %T", oldObj)
+	}
+	svc, ok := newObj.(*corev1.Service)
+	if !ok {
+		return nil, fmt.Errorf("expected a Service object but got %T", newObj)
+	}
+
+	// Only validate when NetBird annotations change, keeping unrelated Service updates cheap
+	newAnnotations := netbirdAnnotations(svc.Annotations)
+	if len(newAnnotations) == 0 || maps.Equal(netbirdAnnotations(oldSvc.Annotations), newAnnotations) {
+		return nil, nil
+	}
+	servicelog.Info("Validation for Service upon update", "namespace", svc.Namespace, "name", svc.Name)
+
+	return v.validateAnnotations(ctx, svc)
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type Service.
+func (v *ServiceCustomValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateAnnotations rejects invalid NetBird annotations, warning about policies that won't be applied
+func (v *ServiceCustomValidator) validateAnnotations(ctx context.Context, svc *corev1.Service) (admission.Warnings, error) {
+	err := controller.ValidateServiceAnnotations(svc.Annotations)
+	if err != nil {
+		return nil, err
+	}
+
+	var warnings admission.Warnings
+	policies, sourceGroups := controller.ServicePolicies(svc.Annotations)
+	for _, policy := range policies {
+		var nbPolicy netbirdiov1.NBPolicy
+		err = v.client.Get(ctx, types.NamespacedName{Name: policy}, &nbPolicy)
+		if err != nil && !errors.IsNotFound(err) {
+			return nil, err
+		}
+		if errors.IsNotFound(err) {
+			if len(sourceGroups) == 0 {
+				warnings = append(warnings, fmt.Sprintf("NBPolicy %s not found and netbird.io/policy-source-groups is not set, policy will not be generated", policy))
+			} else {
+				warnings = append(warnings, fmt.Sprintf("NBPolicy %s not found, policy is only generated with automatic policy creation allowed", policy))
+			}
+		}
+	}
+
+	return warnings, nil
+}
+
+// netbirdAnnotations netbird.io annotations out of annotations
+func netbirdAnnotations(annotations map[string]string) map[string]string {
+	filtered := make(map[string]string)
+	for k, v := range annotations {
+		if strings.HasPrefix(k, "netbird.io/") {
+			filtered[k] = v
+		}
+	}
+	return filtered
+}
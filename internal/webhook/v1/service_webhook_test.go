@@ -0,0 +1,80 @@
+package v1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Service Webhook", func() {
+	var (
+		obj       *corev1.Service
+		oldObj    *corev1.Service
+		validator ServiceCustomValidator
+	)
+
+	BeforeEach(func() {
+		obj = &corev1.Service{
+			ObjectMeta: v1.ObjectMeta{
+				Name:      "svc",
+				Namespace: "default",
+			},
+		}
+		oldObj = obj.DeepCopy()
+		validator = ServiceCustomValidator{
+			client: k8sClient,
+		}
+	})
+
+	Context("When creating or updating Service under Validating Webhook", func() {
+		It("should allow Services without NetBird annotations", func() {
+			Expect(validator.ValidateCreate(ctx, obj)).Error().NotTo(HaveOccurred())
+		})
+
+		It("should allow valid annotations", func() {
+			obj.Annotations = map[string]string{
+				"netbird.io/expose":          "true",
+				"netbird.io/policy-ports":    "80,443",
+				"netbird.io/policy-protocol": "tcp",
+				"netbird.io/policy-name":     "default:Default policy",
+				"netbird.io/network":         "kube-system/router",
+			}
+			Expect(validator.ValidateCreate(ctx, obj)).Error().NotTo(HaveOccurred())
+		})
+
+		It("should reject invalid policy ports", func() {
+			obj.Annotations = map[string]string{"netbird.io/policy-ports": "80,https"}
+			Expect(validator.ValidateCreate(ctx, obj)).Error().To(HaveOccurred())
+		})
+
+		It("should reject invalid policy protocol", func() {
+			obj.Annotations = map[string]string{"netbird.io/policy-protocol": "icmp"}
+			Expect(validator.ValidateCreate(ctx, obj)).Error().To(HaveOccurred())
+		})
+
+		It("should reject invalid policy names", func() {
+			obj.Annotations = map[string]string{"netbird.io/policy-name": "default"}
+			Expect(validator.ValidateCreate(ctx, obj)).Error().To(HaveOccurred())
+		})
+
+		It("should warn about unknown policies without source groups", func() {
+			obj.Annotations = map[string]string{"netbird.io/policy": "does-not-exist"}
+			warnings, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(HaveLen(1))
+			Expect(warnings[0]).To(ContainSubstring("netbird.io/policy-source-groups"))
+		})
+
+		It("should skip updates not changing NetBird annotations", func() {
+			oldObj.Annotations = map[string]string{"netbird.io/policy-ports": "invalid"}
+			obj.Annotations = map[string]string{"netbird.io/policy-ports": "invalid", "other": "value"}
+			Expect(validator.ValidateUpdate(ctx, oldObj, obj)).Error().NotTo(HaveOccurred())
+		})
+
+		It("should validate updates changing NetBird annotations", func() {
+			obj.Annotations = map[string]string{"netbird.io/policy-ports": "99999"}
+			Expect(validator.ValidateUpdate(ctx, oldObj, obj)).Error().To(HaveOccurred())
+		})
+	})
+})